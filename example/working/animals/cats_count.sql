@@ -0,0 +1,6 @@
+DELIMITER $$
+CREATE PROCEDURE `cats_count`()
+BEGIN
+    SELECT COUNT(*) AS `count` FROM `cats`;
+END$$
+DELIMITER ;
@@ -0,0 +1,99 @@
+package approval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/mysql"
+	"github.com/freneticmonkey/migrate/go/test"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// Approval An approval of a Migration by a reviewer
+type Approval struct {
+	AID       int64  `db:"aid,autoincrement,primarykey" json:"aid"`
+	MID       int64  `db:"mid" json:"mid"`
+	Approver  string `db:"approver" json:"approver"`
+	Comment   string `db:"comment,size:1024" json:"comment"`
+	Timestamp string `db:"timestamp" json:"timestamp"`
+}
+
+// Insert Insert the Approval into the Management DB
+func (a *Approval) Insert() (err error) {
+	if err = configured(); err != nil {
+		return err
+	}
+	if len(a.Timestamp) == 0 {
+		a.Timestamp = mysql.GetTimeNow()
+	}
+	err = mgmtDb.Insert(a)
+	util.ErrorCheckf(err, "Inserting the Approval of Migration: [%d] by: [%s] into the DB failed", a.MID, a.Approver)
+	return err
+}
+
+// ToDBRow Used to convert the Approval into a unit test DBRow
+func (a Approval) ToDBRow() test.DBRow {
+	return test.DBRow{
+		a.AID,
+		a.MID,
+		a.Approver,
+		a.Comment,
+		a.Timestamp,
+	}
+}
+
+// Load Load the Approvals of the Migration in the order they were given
+func Load(mid int64) (approvals []Approval, err error) {
+	if err = configured(); err != nil {
+		return approvals, err
+	}
+	query := fmt.Sprintf("SELECT * FROM `migration_approval` WHERE mid=%d ORDER BY aid", mid)
+	_, err = mgmtDb.Select(&approvals, query)
+	util.ErrorCheckf(err, "Unable to load the Approvals of Migration: [%d]", mid)
+	return approvals, err
+}
+
+// Approve Record the approval of the Migration by the approver.  The author of the Git
+// version can't approve its Migration unless the policy of the environment allows it.
+// The approver isn't authenticated here, callers must supply an identity they trust.
+func Approve(m *migration.Migration, approver string, comment string, env string, approval config.Approval) (a Approval, err error) {
+	approver = strings.TrimSpace(approver)
+	if len(approver) == 0 {
+		return a, fmt.Errorf("Unable to approve Migration: [%d].  No approver supplied", m.MID)
+	}
+
+	policy, found := approval.PolicyFor(env)
+	if found && !policy.SelfApproval && IsAuthor(approver, m.Author()) {
+		return a, fmt.Errorf("Unable to approve Migration: [%d].  Approver: [%s] is the author of Version: [%s]", m.MID, approver, m.Version)
+	}
+
+	a = Approval{
+		MID:      m.MID,
+		Approver: approver,
+		Comment:  comment,
+	}
+	err = a.Insert()
+	return a, err
+}
+
+// IsAuthor Returns true if the approver is the Git author, identified by its name, email
+// address or the user of its email address. e.g. "Jane Doe <jdoe@example.com>"
+func IsAuthor(approver string, author string) bool {
+	approver = strings.ToLower(strings.TrimSpace(approver))
+	author = strings.ToLower(strings.TrimSpace(author))
+	if len(approver) == 0 || len(author) == 0 {
+		return false
+	}
+
+	identities := []string{author}
+	if start := strings.Index(author, "<"); start >= 0 {
+		email := strings.Trim(author[start:], "<> ")
+		identities = append(identities, strings.TrimSpace(author[:start]), email)
+		if at := strings.Index(email, "@"); at > 0 {
+			identities = append(identities, email[:at])
+		}
+	}
+	return util.StringInArray(approver, identities)
+}
@@ -0,0 +1,212 @@
+package approval
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/test"
+)
+
+const gitDetails = `commit abc123
+    Author: Jane Doe <jdoe@example.com>
+    Date:   Tue Jul 12 22:04:05 2016 +1000
+
+    An example git commit for unit testing`
+
+func TestIsAuthor(t *testing.T) {
+	var tests = []struct {
+		Approver string
+		Expected bool
+	}{
+		{"Jane Doe <jdoe@example.com>", true},
+		{"jane doe", true},
+		{"JDoe@example.com", true},
+		{"jdoe", true},
+		{"jsmith", false},
+		{"", false},
+	}
+
+	for _, tst := range tests {
+		if result := IsAuthor(tst.Approver, "Jane Doe <jdoe@example.com>"); result != tst.Expected {
+			t.Errorf("IsAuthor FAILED for Approver: [%s]. Expected: %t Got: %t", tst.Approver, tst.Expected, result)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	policies := config.Approval{
+		DBAs: []string{"dba"},
+		Policies: []config.ApprovalPolicy{
+			{
+				Env:               "PROD",
+				Approvers:         2,
+				DBAForDestructive: true,
+			},
+		},
+	}
+
+	addStep := migration.Step{SID: 1, MID: 1, Op: table.Add, Forward: "ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;"}
+	dropStep := migration.Step{SID: 2, MID: 1, Op: table.Del, Forward: "ALTER TABLE `dogs` DROP COLUMN `name`;"}
+
+	approvalRow := func(aid int64, approver string) test.DBRow {
+		return Approval{AID: aid, MID: 1, Approver: approver, Timestamp: "2016-07-12 12:04:05"}.ToDBRow()
+	}
+
+	var tests = []struct {
+		Description       string
+		Env               string
+		Steps             []migration.Step
+		Approvals         []test.DBRow
+		ExpectedApprovers []string
+		ExpectedIgnored   []string
+		ExpectedSatisfied bool
+		ExpectedReason    string
+	}{
+		{
+			Description:       "No policy for the environment",
+			Env:               "DEV",
+			ExpectedApprovers: []string{},
+			ExpectedIgnored:   []string{},
+			ExpectedSatisfied: true,
+		},
+		{
+			Description:       "Distinct approvers",
+			Env:               "PROD",
+			Steps:             []migration.Step{addStep},
+			Approvals:         []test.DBRow{approvalRow(1, "alice"), approvalRow(2, "bob")},
+			ExpectedApprovers: []string{"alice", "bob"},
+			ExpectedIgnored:   []string{},
+			ExpectedSatisfied: true,
+		},
+		{
+			Description:       "Repeated approvals are counted once",
+			Env:               "PROD",
+			Steps:             []migration.Step{addStep},
+			Approvals:         []test.DBRow{approvalRow(1, "alice"), approvalRow(2, "Alice")},
+			ExpectedApprovers: []string{"alice"},
+			ExpectedIgnored:   []string{},
+			ExpectedReason:    "requires 2 distinct approver(s), has 1",
+		},
+		{
+			Description:       "The author can't approve",
+			Env:               "prod",
+			Steps:             []migration.Step{addStep},
+			Approvals:         []test.DBRow{approvalRow(1, "alice"), approvalRow(2, "jdoe")},
+			ExpectedApprovers: []string{"alice"},
+			ExpectedIgnored:   []string{"jdoe"},
+			ExpectedReason:    "requires 2 distinct approver(s), has 1",
+		},
+		{
+			Description:       "Destructive steps require a DBA",
+			Env:               "PROD",
+			Steps:             []migration.Step{addStep, dropStep},
+			Approvals:         []test.DBRow{approvalRow(1, "alice"), approvalRow(2, "bob")},
+			ExpectedApprovers: []string{"alice", "bob"},
+			ExpectedIgnored:   []string{},
+			ExpectedReason:    "destructive steps require the approval of a DBA",
+		},
+		{
+			Description:       "Destructive steps approved by a DBA",
+			Env:               "PROD",
+			Steps:             []migration.Step{addStep, dropStep},
+			Approvals:         []test.DBRow{approvalRow(1, "alice"), approvalRow(2, "DBA")},
+			ExpectedApprovers: []string{"alice", "DBA"},
+			ExpectedIgnored:   []string{},
+			ExpectedSatisfied: true,
+		},
+	}
+
+	for _, tst := range tests {
+		mgmtDB, err := test.CreateManagementDB(tst.Description, t)
+		if err != nil {
+			return
+		}
+		Setup(mgmtDB.Db)
+
+		m := migration.Migration{
+			MID:                1,
+			DB:                 1,
+			Version:            "abc123",
+			VersionDescription: gitDetails,
+			Steps:              tst.Steps,
+		}
+
+		if _, found := policies.PolicyFor(tst.Env); found {
+			mgmtDB.ApprovalGet(m.MID, tst.Approvals)
+		}
+
+		evaluation, err := Evaluate(&m, tst.Env, policies)
+		if err != nil {
+			t.Errorf("%s FAILED with error: %v", tst.Description, err)
+		}
+
+		if evaluation.Satisfied != tst.ExpectedSatisfied {
+			t.Errorf("%s FAILED. Expected Satisfied: %t Got: %t Reasons: %v", tst.Description, tst.ExpectedSatisfied, evaluation.Satisfied, evaluation.Reasons)
+		}
+		if !reflect.DeepEqual(evaluation.Approvers, tst.ExpectedApprovers) {
+			t.Errorf("%s FAILED. Expected Approvers: %v Got: %v", tst.Description, tst.ExpectedApprovers, evaluation.Approvers)
+		}
+		if !reflect.DeepEqual(evaluation.Ignored, tst.ExpectedIgnored) {
+			t.Errorf("%s FAILED. Expected Ignored: %v Got: %v", tst.Description, tst.ExpectedIgnored, evaluation.Ignored)
+		}
+		if len(tst.ExpectedReason) > 0 && !strings.Contains(strings.Join(evaluation.Reasons, ", "), tst.ExpectedReason) {
+			t.Errorf("%s FAILED. Expected Reason: [%s] Got: %v", tst.Description, tst.ExpectedReason, evaluation.Reasons)
+		}
+
+		mgmtDB.ExpectionsMet(tst.Description, t)
+	}
+}
+
+func TestApproveSelf(t *testing.T) {
+	testName := "TestApproveSelf"
+
+	policies := config.Approval{
+		Policies: []config.ApprovalPolicy{
+			{
+				Env:       "PROD",
+				Approvers: 1,
+			},
+		},
+	}
+
+	mgmtDB, err := test.CreateManagementDB(testName, t)
+	if err != nil {
+		return
+	}
+	Setup(mgmtDB.Db)
+
+	m := migration.Migration{
+		MID:                1,
+		Version:            "abc123",
+		VersionDescription: gitDetails,
+	}
+
+	// An unidentified approver is refused
+	_, err = Approve(&m, "  ", "LGTM", "PROD", policies)
+	if err == nil {
+		t.Errorf("%s FAILED. The Migration was approved without an approver", testName)
+	}
+
+	// The author is refused
+	_, err = Approve(&m, "jdoe@example.com", "LGTM", "PROD", policies)
+	if err == nil {
+		t.Errorf("%s FAILED. The author approved their own Migration", testName)
+	}
+
+	// A reviewer's approval is recorded
+	mgmtDB.ApprovalInsert(m.MID, "alice", "LGTM", 1)
+
+	a, err := Approve(&m, "alice", "LGTM", "PROD", policies)
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+	}
+	if a.AID != 1 || len(a.Timestamp) == 0 {
+		t.Errorf("%s FAILED. The Approval wasn't recorded: %v", testName, a)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
@@ -0,0 +1,105 @@
+package approval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/migration"
+)
+
+// Evaluation The result of checking the Approvals of a Migration against the approval
+// policy of an environment
+type Evaluation struct {
+	MID    int64  `json:"mid"`
+	Env    string `json:"env"`
+	Author string `json:"author"`
+	// Policy false if no approval policy applies to the environment
+	Policy   bool `json:"policy"`
+	Required int  `json:"required"`
+	// Approvers The distinct approvers counted towards the policy
+	Approvers []string `json:"approvers"`
+	// Ignored Approvals by the author which don't count towards the policy
+	Ignored     []string   `json:"ignored"`
+	Destructive bool       `json:"destructive"`
+	DBARequired bool       `json:"dba_required"`
+	DBAApproved bool       `json:"dba_approved"`
+	Satisfied   bool       `json:"satisfied"`
+	Reasons     []string   `json:"reasons"`
+	Approvals   []Approval `json:"approvals"`
+}
+
+// Error Returns an error describing why the policy isn't satisfied, or nil if it is
+func (e Evaluation) Error() error {
+	if e.Satisfied {
+		return nil
+	}
+	return fmt.Errorf("Migration: [%d] doesn't satisfy the approval policy of %s: %s", e.MID, e.Env, strings.Join(e.Reasons, ", "))
+}
+
+// Evaluate Check the Approvals of the Migration against the approval policy of the
+// environment.  A Migration is always satisfied if no policy applies to the environment.
+func Evaluate(m *migration.Migration, env string, approval config.Approval) (e Evaluation, err error) {
+	var policy config.ApprovalPolicy
+
+	e = Evaluation{
+		MID:       m.MID,
+		Env:       env,
+		Author:    m.Author(),
+		Approvers: []string{},
+		Ignored:   []string{},
+		Reasons:   []string{},
+	}
+
+	policy, e.Policy = approval.PolicyFor(env)
+	if !e.Policy {
+		e.Satisfied = true
+		return e, err
+	}
+
+	e.Approvals, err = Load(m.MID)
+	if err != nil {
+		return e, err
+	}
+
+	// Count each approver once
+	counted := map[string]bool{}
+	for _, a := range e.Approvals {
+		name := strings.ToLower(strings.TrimSpace(a.Approver))
+		if counted[name] {
+			continue
+		}
+		counted[name] = true
+
+		if !policy.SelfApproval && IsAuthor(a.Approver, e.Author) {
+			e.Ignored = append(e.Ignored, a.Approver)
+			continue
+		}
+		e.Approvers = append(e.Approvers, a.Approver)
+
+		if approval.IsDBA(a.Approver) {
+			e.DBAApproved = true
+		}
+	}
+
+	e.Required = policy.Approvers
+	if len(e.Approvers) < e.Required {
+		e.Reasons = append(e.Reasons, fmt.Sprintf("requires %d distinct approver(s), has %d", e.Required, len(e.Approvers)))
+	}
+
+	for _, step := range m.Steps {
+		checks, _ := step.DataLossChecks()
+		if step.IsDestructive() || len(checks) > 0 {
+			e.Destructive = true
+			break
+		}
+	}
+
+	e.DBARequired = e.Destructive && policy.DBAForDestructive
+	if e.DBARequired && !e.DBAApproved {
+		e.Reasons = append(e.Reasons, "destructive steps require the approval of a DBA")
+	}
+
+	e.Satisfied = len(e.Reasons) == 0
+	return e, err
+}
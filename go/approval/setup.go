@@ -0,0 +1,52 @@
+package approval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/go-gorp/gorp"
+)
+
+var mgmtDb *gorp.DbMap
+
+// Setup Setup the Migration Approval table in the management DB
+func Setup(db *gorp.DbMap) {
+	mgmtDb = db
+
+	if mgmtDb != nil {
+		mgmtDb.AddTableWithName(Approval{}, "migration_approval").SetKeys(true, "AID")
+	}
+}
+
+// CreateTables Create the Migration Approval table if it doesn't already exist, so
+// that existing management databases are upgraded automatically
+func CreateTables() (result bool, err error) {
+
+	createTable := []string{
+		"CREATE TABLE IF NOT EXISTS `migration_approval` (",
+		"  `aid` bigint(20) NOT NULL AUTO_INCREMENT,",
+		"  `mid` bigint(20) NOT NULL,",
+		"  `approver` varchar(255) NOT NULL,",
+		"  `comment` text,",
+		"  `timestamp` varchar(32) NOT NULL DEFAULT '',",
+		"  PRIMARY KEY (`aid`),",
+		"  KEY `mid` (`mid`)",
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8;",
+	}
+	statement := strings.Join(createTable, "\n")
+
+	_, err = mgmtDb.Exec(statement)
+
+	result = !util.ErrorCheckf(err, "Problem creating Migration Approval table in the management DB")
+
+	return result, err
+}
+
+// configured Internal Helper function for checking database validity
+func configured() error {
+	if mgmtDb != nil && mgmtDb.Db != nil {
+		return nil
+	}
+	return fmt.Errorf("Approval: Database not configured.")
+}
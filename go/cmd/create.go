@@ -39,15 +39,21 @@ func GetCreateCommand() (setup cli.Command) {
 				Name:  "no-clone",
 				Usage: "Do not clone from git.  Use this when the yaml files have already been checked out.",
 			},
+			cli.BoolFlag{
+				Name:  "no-coalesce",
+				Usage: "Generate a separate ALTER TABLE statement for each change instead of merging the changes to each table.",
+			},
 		},
 		Action: func(ctx *cli.Context) error {
 			var version string
 			var gitinfo string
 			var rollback bool
 			var clone bool
+			var coalesce bool
 
 			rollback = false
 			clone = true
+			coalesce = true
 
 			// Override the project settings with the command line flags
 			if ctx.IsSet("version") {
@@ -63,6 +69,10 @@ func GetCreateCommand() (setup cli.Command) {
 				clone = false
 			}
 
+			if ctx.IsSet("no-coalesce") {
+				coalesce = false
+			}
+
 			// Parse global flags
 			parseGlobalFlags(ctx)
 
@@ -77,14 +87,14 @@ func GetCreateCommand() (setup cli.Command) {
 				rollback = ctx.Bool("rollback")
 			}
 
-			return create(version, gitinfo, clone, rollback, conf)
+			return create(version, gitinfo, clone, rollback, coalesce, conf)
 
 		},
 	}
 	return setup
 }
 
-func create(version string, gitinfo string, clone bool, rollback bool, conf config.Config) *cli.ExitError {
+func create(version string, gitinfo string, clone bool, rollback bool, coalesce bool, conf config.Config) *cli.ExitError {
 	var problems id.ValidationErrors
 	var ts string
 	var info string
@@ -155,12 +165,45 @@ func create(version string, gitinfo string, clone bool, rollback bool, conf conf
 	if util.ErrorCheckf(err, "Diff Failed while generating forward migration") {
 		return cli.NewExitError("Create failed. Unable to generate a forward migration", 1)
 	}
+	forwardViewDiff, err := table.DiffViews(yaml.Views, mysql.Views, false, true)
+	if util.ErrorCheckf(err, "View Diff Failed while generating forward migration") {
+		return cli.NewExitError("Create failed. Unable to generate a forward migration", 1)
+	}
+	// Views are applied after the tables they select from
+	forwardDiff.Merge(forwardViewDiff)
+	forwardRoutineDiff, err := table.DiffRoutines(yaml.Routines, mysql.Routines, false, true)
+	if util.ErrorCheckf(err, "Routine Diff Failed while generating forward migration") {
+		return cli.NewExitError("Create failed. Unable to generate a forward migration", 1)
+	}
+	// Routines are applied after the tables and views they reference
+	forwardDiff.Merge(forwardRoutineDiff)
+	forwardEventDiff, err := table.DiffEvents(yaml.Events, mysql.Events, false, true)
+	if util.ErrorCheckf(err, "Event Diff Failed while generating forward migration") {
+		return cli.NewExitError("Create failed. Unable to generate a forward migration", 1)
+	}
+	// Events are applied after the objects they may reference
+	forwardDiff.Merge(forwardEventDiff)
 	forwardOps := mysql.GenerateAlters(forwardDiff)
 
 	backwardDiff, err := table.DiffTables(yaml.Schema, mysql.Schema, false, false)
 	if util.ErrorCheckf(err, "Diff Failed while generating backward migration") {
 		return cli.NewExitError("Create failed. Unable to generate a backward migration", 1)
 	}
+	backwardViewDiff, err := table.DiffViews(yaml.Views, mysql.Views, false, false)
+	if util.ErrorCheckf(err, "View Diff Failed while generating backward migration") {
+		return cli.NewExitError("Create failed. Unable to generate a backward migration", 1)
+	}
+	backwardDiff.Merge(backwardViewDiff)
+	backwardRoutineDiff, err := table.DiffRoutines(yaml.Routines, mysql.Routines, false, false)
+	if util.ErrorCheckf(err, "Routine Diff Failed while generating backward migration") {
+		return cli.NewExitError("Create failed. Unable to generate a backward migration", 1)
+	}
+	backwardDiff.Merge(backwardRoutineDiff)
+	backwardEventDiff, err := table.DiffEvents(yaml.Events, mysql.Events, false, false)
+	if util.ErrorCheckf(err, "Event Diff Failed while generating backward migration") {
+		return cli.NewExitError("Create failed. Unable to generate a backward migration", 1)
+	}
+	backwardDiff.Merge(backwardEventDiff)
 	backwardOps := mysql.GenerateAlters(backwardDiff)
 
 	m, err := migration.New(migration.Param{
@@ -171,6 +214,10 @@ func create(version string, gitinfo string, clone bool, rollback bool, conf conf
 		Forwards:    forwardOps,
 		Backwards:   backwardOps,
 		Rollback:    rollback,
+		Coalesce:    coalesce,
+		Algorithm:   conf.Project.Migration.Algorithm,
+		Env:         conf.Project.DB.Environment,
+		Promotion:   conf.Project.Promotion,
 	})
 	if util.ErrorCheck(err) {
 		return cli.NewExitError("Create failed. Unable to create new Migration in the management database", 1)
@@ -27,8 +27,9 @@ func TestCreateFailNoProject(t *testing.T) {
 	gitinfo := ""
 	clone := true
 	rollback := false
+	coalesce := true
 
-	result := create(version, gitinfo, clone, rollback, testConfig)
+	result := create(version, gitinfo, clone, rollback, coalesce, testConfig)
 
 	if result.ExitCode() < 1 {
 		t.Errorf("%s succeeded when it should have failed.", testName)
@@ -48,10 +49,11 @@ func TestCreateFailNoProjectVersion(t *testing.T) {
 	gitinfo := ""
 	clone := true
 	rollback := false
+	coalesce := true
 
 	testConfig.Project.Git.Version = version
 
-	result := create(version, gitinfo, clone, rollback, testConfig)
+	result := create(version, gitinfo, clone, rollback, coalesce, testConfig)
 
 	if result.ExitCode() < 1 {
 		t.Errorf("%s succeeded when it should have failed.", testName)
@@ -74,6 +76,7 @@ func TestCreate(t *testing.T) {
 	gitinfo := ""
 	clone := true
 	rollback := false
+	coalesce := true
 
 	// Configure testing data
 	testConfig := test.GetTestConfig()
@@ -190,7 +193,7 @@ schemaTwo/*`
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	}
@@ -346,6 +349,9 @@ schemaTwo/*`
 			gitDetails,
 			0,
 			"",
+			"",
+			"",
+			0,
 		},
 		1,
 		1,
@@ -363,6 +369,15 @@ schemaTwo/*`
 			"",
 			0,
 			"",
+			false,
+			"",
+			"",
+			"",
+			0,
+			"",
+			"",
+			0,
+			"dogs",
 		},
 		1,
 		1,
@@ -371,7 +386,7 @@ schemaTwo/*`
 	//
 	////////////////////////////////////////////////////////
 
-	result = create(version, gitinfo, clone, rollback, testConfig)
+	result = create(version, gitinfo, clone, rollback, coalesce, testConfig)
 
 	if result.ExitCode() > 0 {
 		t.Errorf("%s failed with error: %v", testName, result)
@@ -435,6 +450,7 @@ func TestCreateRollback(t *testing.T) {
 	gitinfo := ""
 	clone := true
 	rollback := true
+	coalesce := true
 
 	// Configure testing data
 	testConfig := test.GetTestConfig()
@@ -561,7 +577,7 @@ schemaTwo/*`
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	}
@@ -722,6 +738,9 @@ schemaTwo/*`
 			gitDetails,
 			0,
 			"",
+			"",
+			"",
+			0,
 		},
 		1,
 		1,
@@ -739,6 +758,15 @@ schemaTwo/*`
 			"",
 			0,
 			"",
+			false,
+			"",
+			"",
+			"",
+			0,
+			"",
+			"",
+			0,
+			"dogs",
 		},
 		1,
 		1,
@@ -747,7 +775,7 @@ schemaTwo/*`
 	//
 	////////////////////////////////////////////////////////
 
-	result = create(version, gitinfo, clone, rollback, testConfig)
+	result = create(version, gitinfo, clone, rollback, coalesce, testConfig)
 
 	if result.ExitCode() > 0 {
 		t.Errorf("%s failed with error: %v", testName, result)
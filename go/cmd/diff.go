@@ -118,6 +118,38 @@ func diff(project, version, tableName string, conf config.Config) *cli.ExitError
 		}
 		// Reduce the YAML schema to the single target table
 		yaml.Schema = tgtTbl
+
+		tgtView := []table.View{}
+
+		for _, view := range yaml.Views {
+			if view.Name == tableName {
+				tgtView = append(tgtView, view)
+				targetTableFound = true
+				break
+			}
+		}
+		yaml.Views = tgtView
+
+		tgtRoutine := []table.Routine{}
+
+		for _, routine := range yaml.Routines {
+			if routine.Name == tableName {
+				tgtRoutine = append(tgtRoutine, routine)
+				targetTableFound = true
+			}
+		}
+		yaml.Routines = tgtRoutine
+
+		tgtEvent := []table.Event{}
+
+		for _, event := range yaml.Events {
+			if event.Name == tableName {
+				tgtEvent = append(tgtEvent, event)
+				targetTableFound = true
+				break
+			}
+		}
+		yaml.Events = tgtEvent
 	}
 
 	// Read the MySQL tables from the target database
@@ -143,6 +175,38 @@ func diff(project, version, tableName string, conf config.Config) *cli.ExitError
 		}
 		// Reduce the YAML schema to the single target table
 		mysql.Schema = tgtTbl
+
+		tgtView := []table.View{}
+
+		for _, view := range mysql.Views {
+			if view.Name == tableName {
+				tgtView = append(tgtView, view)
+				targetTableFound = true
+				break
+			}
+		}
+		mysql.Views = tgtView
+
+		tgtRoutine := []table.Routine{}
+
+		for _, routine := range mysql.Routines {
+			if routine.Name == tableName {
+				tgtRoutine = append(tgtRoutine, routine)
+				targetTableFound = true
+			}
+		}
+		mysql.Routines = tgtRoutine
+
+		tgtEvent := []table.Event{}
+
+		for _, event := range mysql.Events {
+			if event.Name == tableName {
+				tgtEvent = append(tgtEvent, event)
+				targetTableFound = true
+				break
+			}
+		}
+		mysql.Events = tgtEvent
 	}
 
 	problems, err = id.ValidatePropertyIDs(yaml.Schema, mysql.Schema, true)
@@ -159,6 +223,28 @@ func diff(project, version, tableName string, conf config.Config) *cli.ExitError
 	if util.ErrorCheck(err) {
 		return cli.NewExitError("Validation failed. Problems determining differences", 1)
 	}
+
+	viewDiff, err := table.DiffViews(yaml.Views, mysql.Views, true, true)
+	if util.ErrorCheck(err) {
+		return cli.NewExitError("Validation failed. Problems determining View differences", 1)
+	}
+	// Views are applied after the tables they select from
+	forwardDiff.Merge(viewDiff)
+
+	routineDiff, err := table.DiffRoutines(yaml.Routines, mysql.Routines, true, true)
+	if util.ErrorCheck(err) {
+		return cli.NewExitError("Validation failed. Problems determining Routine differences", 1)
+	}
+	// Routines are applied after the tables and views they reference
+	forwardDiff.Merge(routineDiff)
+
+	eventDiff, err := table.DiffEvents(yaml.Events, mysql.Events, true, true)
+	if util.ErrorCheck(err) {
+		return cli.NewExitError("Validation failed. Problems determining Event differences", 1)
+	}
+	// Events are applied after the objects they may reference
+	forwardDiff.Merge(eventDiff)
+
 	util.VerboseOverrideSet(true)
 	mysql.GenerateAlters(forwardDiff)
 	util.VerboseOverrideRestore()
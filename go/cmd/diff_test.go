@@ -83,7 +83,7 @@ func TestDiff(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	} else {
@@ -208,7 +208,7 @@ func TestDiffTableName(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	} else {
@@ -333,7 +333,7 @@ func TestDiffTableNameFailed(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	} else {
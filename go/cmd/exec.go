@@ -55,6 +55,18 @@ func GetExecCommand() (setup cli.Command) {
 				Name:  "force-ci",
 				Usage: "Force apply the migration.  For use with a Continuous Integration pipeline.",
 			},
+			cli.BoolFlag{
+				Name:  "rollback-on-failure",
+				Usage: "If a step fails, roll back the steps already applied by the migration in reverse order.",
+			},
+			cli.BoolFlag{
+				Name:  "resume",
+				Usage: "Resume a failed migration.  Applied steps are verified against the live schema and the failed step is retried.",
+			},
+			cli.BoolFlag{
+				Name:  "recover",
+				Usage: "Clear the InProgress state of a migration whose process was interrupted so that it can be resumed.",
+			},
 		},
 		Action: func(ctx *cli.Context) (err error) {
 			var mid int64
@@ -119,12 +131,22 @@ func GetExecCommand() (setup cli.Command) {
 				return cli.NewExitError("Migration printed.", 0)
 			}
 
+			if ctx.Bool("recover") {
+				err = exec.Recover(mid)
+				if util.ErrorCheck(err) {
+					return cli.NewExitError(fmt.Sprintf("Recover failed. Unable to recover Migration with ID: [%d].", mid), 1)
+				}
+				return cli.NewExitError(fmt.Sprintf("Migration recovered with ID: %d", mid), 0)
+			}
+
 			dryrun := ctx.Bool("dryrun")
 			rollback := ctx.Bool("rollback")
 			PTODisabled := ctx.Bool("pto-disabled")
 			allowDestructive := ctx.Bool("allow-destructive")
 			stepConfirm := ctx.Bool("step-confirm")
 			forceCI := ctx.Bool("force-ci")
+			resume := ctx.Bool("resume")
+			rollbackOnFailure := ctx.Bool("rollback-on-failure")
 
 			err = exec.Exec(exec.Options{
 				MID:              mid,
@@ -134,6 +156,8 @@ func GetExecCommand() (setup cli.Command) {
 				PTODisabled:      PTODisabled,
 				AllowDestructive: allowDestructive,
 				StepConfirm:	  stepConfirm,
+				Resume:           resume,
+				RollbackOnFailure: rollbackOnFailure,
 			})
 
 			if util.ErrorCheck(err) {
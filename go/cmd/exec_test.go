@@ -1,12 +1,18 @@
 package cmd
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 
+	"github.com/freneticmonkey/migrate/go/approval"
+	"github.com/freneticmonkey/migrate/go/config"
 	"github.com/freneticmonkey/migrate/go/exec"
+	"github.com/freneticmonkey/migrate/go/lock"
 	"github.com/freneticmonkey/migrate/go/metadata"
 	"github.com/freneticmonkey/migrate/go/migration"
 	"github.com/freneticmonkey/migrate/go/mysql"
@@ -109,9 +115,10 @@ func TestExecDryrun(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -139,6 +146,7 @@ func TestExecDryrun(t *testing.T) {
 
 	// Get the latest Migration
 	mgmtDB.MigrationGetLatest(
+		1,
 		m.ToDBRow(),
 		false,
 	)
@@ -309,9 +317,10 @@ func TestExecRollback(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -323,6 +332,9 @@ func TestExecRollback(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, olderMID)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -339,6 +351,7 @@ func TestExecRollback(t *testing.T) {
 
 	// Get the latest Migration
 	// mgmtDB.MigrationGetLatest(
+	// 	1,
 	// 	latestMig.ToDBRow(),
 	// 	false,
 	// )
@@ -369,6 +382,9 @@ func TestExecRollback(t *testing.T) {
 		olderMig.VersionDescription,
 		migration.InProgress,
 		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		olderMig.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -383,6 +399,15 @@ func TestExecRollback(t *testing.T) {
 		olderStep.Output,
 		migration.Approved,
 		"",
+		olderStep.Safe,
+		olderStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		olderStep.TableName,
 		olderStep.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -406,6 +431,15 @@ func TestExecRollback(t *testing.T) {
 		olderStep.Output,
 		migration.InProgress,
 		"",
+		olderStep.Safe,
+		olderStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		olderStep.TableName,
 		olderStep.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -441,6 +475,15 @@ func TestExecRollback(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.Rollback,
 		"",
+		olderStep.Safe,
+		olderStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		olderStep.TableName,
 		olderStep.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -471,6 +514,9 @@ func TestExecRollback(t *testing.T) {
 		olderMig.VersionDescription,
 		migration.Rollback,
 		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		olderMig.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -485,9 +531,21 @@ func TestExecRollback(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.Rollback,
 		"",
+		olderStep.Safe,
+		olderStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		olderStep.TableName,
 		olderStep.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              olderMID,
 		Dryrun:           dryrun,
@@ -644,9 +702,10 @@ func TestExecFailInvalidRollback(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -658,6 +717,9 @@ func TestExecFailInvalidRollback(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, olderMID)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -674,6 +736,7 @@ func TestExecFailInvalidRollback(t *testing.T) {
 
 	// Get the latest Migration
 	mgmtDB.MigrationGetLatest(
+		1,
 		latestMig.ToDBRow(),
 		false,
 	)
@@ -690,6 +753,9 @@ func TestExecFailInvalidRollback(t *testing.T) {
 	//
 	////////////////////////////////////////////////////////////
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              olderMID,
 		Dryrun:           dryrun,
@@ -805,9 +871,10 @@ func TestExecRollbackDryrun(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -835,6 +902,7 @@ func TestExecRollbackDryrun(t *testing.T) {
 
 	// Get the latest Migration
 	// mgmtDB.MigrationGetLatest(
+	// 	1,
 	// 	latestMig.ToDBRow(),
 	// 	false,
 	// )
@@ -975,9 +1043,10 @@ func TestExecAllowDestructive(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -989,6 +1058,9 @@ func TestExecAllowDestructive(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -1005,6 +1077,7 @@ func TestExecAllowDestructive(t *testing.T) {
 
 	// Get the latest Migration
 	mgmtDB.MigrationGetLatest(
+		1,
 		m.ToDBRow(),
 		false,
 	)
@@ -1035,6 +1108,9 @@ func TestExecAllowDestructive(t *testing.T) {
 		m.VersionDescription,
 		migration.InProgress,
 		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		m.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1049,6 +1125,15 @@ func TestExecAllowDestructive(t *testing.T) {
 		step.Output,
 		migration.Approved,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1072,6 +1157,15 @@ func TestExecAllowDestructive(t *testing.T) {
 		step.Output,
 		migration.InProgress,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1107,6 +1201,15 @@ func TestExecAllowDestructive(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.ForcedCI,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1128,6 +1231,9 @@ func TestExecAllowDestructive(t *testing.T) {
 		tableMD.MDID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Mark all of the table's fields as non-existant
+	mgmtDB.MetadataMarkNonExistTable(tableMD.Name, 1)
+
 	// Update Migration with completed
 	mgmtDB.Mock.ExpectExec("update `migration`").WithArgs(
 		m.DB,
@@ -1137,6 +1243,9 @@ func TestExecAllowDestructive(t *testing.T) {
 		m.VersionDescription,
 		migration.ForcedCI,
 		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		m.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1151,9 +1260,21 @@ func TestExecAllowDestructive(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.ForcedCI,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              mid,
 		Dryrun:           dryrun,
@@ -1267,9 +1388,10 @@ func TestExecFailAllowDestructive(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -1281,6 +1403,9 @@ func TestExecFailAllowDestructive(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -1297,6 +1422,7 @@ func TestExecFailAllowDestructive(t *testing.T) {
 
 	// Get the latest Migration
 	mgmtDB.MigrationGetLatest(
+		1,
 		m.ToDBRow(),
 		false,
 	)
@@ -1313,6 +1439,9 @@ func TestExecFailAllowDestructive(t *testing.T) {
 	//
 	////////////////////////////////////////////////////////////
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              mid,
 		Dryrun:           dryrun,
@@ -1420,9 +1549,10 @@ func TestExecFailMigrationRunning(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -1434,6 +1564,9 @@ func TestExecFailMigrationRunning(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -1450,6 +1583,7 @@ func TestExecFailMigrationRunning(t *testing.T) {
 
 	// Get the latest Migration
 	mgmtDB.MigrationGetLatest(
+		1,
 		m.ToDBRow(),
 		false,
 	)
@@ -1466,6 +1600,9 @@ func TestExecFailMigrationRunning(t *testing.T) {
 	//
 	////////////////////////////////////////////////////////////
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              mid,
 		Dryrun:           dryrun,
@@ -1577,9 +1714,10 @@ func TestExecFailDenied(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -1591,6 +1729,9 @@ func TestExecFailDenied(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -1605,6 +1746,9 @@ func TestExecFailDenied(t *testing.T) {
 		false,
 	)
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              mid,
 		Dryrun:           dryrun,
@@ -1716,9 +1860,10 @@ func TestExecFailAlreadyExecute(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -1730,6 +1875,9 @@ func TestExecFailAlreadyExecute(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -1744,6 +1892,9 @@ func TestExecFailAlreadyExecute(t *testing.T) {
 		false,
 	)
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              mid,
 		Dryrun:           dryrun,
@@ -1860,6 +2011,9 @@ func TestExec(t *testing.T) {
 	// Migration id
 	mid := int64(1)
 
+	// The host applying the migration
+	host, _ := lock.Owner()
+
 	step := migration.Step{
 		SID:      1,
 		MID:      1,
@@ -1910,9 +2064,10 @@ func TestExec(t *testing.T) {
 	mgmtDB, err = test.CreateManagementDB(testName, t)
 
 	if err == nil {
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
 	} else {
 		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
 		return
@@ -1924,6 +2079,9 @@ func TestExec(t *testing.T) {
 	////////////////////////////////////////////////////////////
 	// Verify that the Migration can run
 
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
 	// Load the requested migration
 	mgmtDB.MigrationGet(
 		1,
@@ -1940,6 +2098,7 @@ func TestExec(t *testing.T) {
 
 	// Get the latest Migration
 	mgmtDB.MigrationGetLatest(
+		1,
 		m.ToDBRow(),
 		false,
 	)
@@ -1970,6 +2129,9 @@ func TestExec(t *testing.T) {
 		m.VersionDescription,
 		migration.InProgress,
 		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		m.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1984,6 +2146,15 @@ func TestExec(t *testing.T) {
 		step.Output,
 		migration.Approved,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -2007,6 +2178,15 @@ func TestExec(t *testing.T) {
 		step.Output,
 		migration.InProgress,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -2031,7 +2211,7 @@ func TestExec(t *testing.T) {
 	// Update the Management DB with the result of the migration
 	// in this case success.
 
-	// Set Migration Step to Complete
+	// Set Migration Step to Complete, recording the host, executor and rows affected
 	mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
 		step.MID,
 		step.Op,
@@ -2042,6 +2222,15 @@ func TestExec(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.Complete,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		host,
+		exec.ExecutorNative,
+		1,
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -2072,6 +2261,9 @@ func TestExec(t *testing.T) {
 		m.VersionDescription,
 		migration.Complete,
 		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		m.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -2086,9 +2278,21 @@ func TestExec(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.Complete,
 		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
 	err = exec.Exec(exec.Options{
 		MID:              mid,
 		Dryrun:           dryrun,
@@ -2106,3 +2310,1106 @@ func TestExec(t *testing.T) {
 
 	mgmtDB.ExpectionsMet(testName, t)
 }
+
+func TestExecResume(t *testing.T) {
+	testName := "TestExecResume"
+
+	util.LogAlert(testName)
+	var err error
+	var projectDB test.ProjectDB
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	////////////////////////////////////////////////////////
+	// Configure testing data
+	//
+
+	// GitVersionTime
+	gitMySQLTime := "2016-07-12 12:04:05"
+
+	// GitVersionDetails
+	gitDetails := `commit abc123
+    Author: Scott Porter <sporter@ea.com>
+    Date:   Tue Jul 12 22:04:05 2016 +1000
+
+    An example git commit for unit testing`
+
+	// Setup table data
+	testConfig := test.GetTestConfig()
+	dogsAddTbl := testdata.GetTableAddressDogs()
+
+	// The Column added by the applied step
+	colMd := dogsAddTbl.Columns[1].Metadata
+	colMd.MDID = 4
+
+	// The Column added by the failed step
+	ageMd := metadata.Metadata{
+		MDID:       5,
+		DB:         1,
+		PropertyID: "age",
+		ParentID:   "dogs",
+		Name:       "age",
+		Type:       "Column",
+	}
+
+	// Migration Configuration - resume the failed migration
+	dryrun := false
+	PTODisabled := true
+	resume := true
+
+	// Migration id
+	mid := int64(1)
+
+	appliedStep := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     4,
+		Name:     "address",
+		Forward:  "ALTER TABLE `unittestproject_dogs` ADD COLUMN `address` varchar(128) NOT NULL;",
+		Backward: "ALTER TABLE `unittestproject_dogs` DROP COLUMN `address`;",
+		Output:   "Row(s) Affected: 1",
+		Status:   migration.Complete,
+	}
+
+	failedStep := migration.Step{
+		SID:      2,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     5,
+		Name:     "age",
+		Forward:  "ALTER TABLE `unittestproject_dogs` ADD COLUMN `age` int(11) NOT NULL;",
+		Backward: "ALTER TABLE `unittestproject_dogs` DROP COLUMN `age`;",
+		Output:   "Failed with Error: Lock wait timeout exceeded",
+		Status:   migration.Failed,
+	}
+
+	m := migration.Migration{
+		MID:                1,
+		DB:                 1,
+		Project:            testConfig.Project.Name,
+		Version:            testConfig.Project.Git.Version,
+		VersionTimestamp:   gitMySQLTime,
+		VersionDescription: gitDetails,
+		Status:             migration.Failed,
+		Timestamp:          mysql.GetTimeNow(),
+		Steps: []migration.Step{
+			appliedStep,
+			failedStep,
+		},
+	}
+
+	//
+	////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////
+	// Configure MySQL access for the management and project DBs
+	//
+
+	projectDB, err = test.CreateProjectDB(testName, t)
+
+	if err == nil {
+		exec.SetProjectDB(projectDB.Db)
+	} else {
+		t.Errorf("%s failed to setup the Project DB with error: %v", testName, err)
+		return
+	}
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+
+	if err == nil {
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+		migration.Setup(mgmtDB.Db, 1)
+		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
+	} else {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// Verify that the Migration can be resumed
+
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
+	mgmtDB.MigrationGet(
+		1,
+		m.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.MigrationStepsGet(
+		1,
+		[]test.DBRow{
+			appliedStep.ToDBRow(),
+			failedStep.ToDBRow(),
+		},
+		false,
+	)
+
+	mgmtDB.MigrationGetLatest(
+		1,
+		m.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.MigrationGetStatus(
+		migration.InProgress,
+		[]test.DBRow{
+			{},
+		},
+		true,
+	)
+
+	// Verify the applied step against the live schema
+	mgmtDB.MetadataGet(
+		4,
+		colMd.ToDBRow(),
+		false,
+	)
+
+	projectDB.ExpectQuery(test.DBQueryMock{
+		Query:   "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'unittestproject_dogs' AND COLUMN_NAME = 'address'",
+		Columns: []string{"COUNT(*)"},
+		Rows:    []test.DBRow{{1}},
+	})
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// Setup for the resumed migration
+
+	mgmtDB.Mock.ExpectExec("update `migration`").WithArgs(
+		m.DB,
+		testConfig.Project.Name,
+		testConfig.Project.Git.Version,
+		m.VersionTimestamp,
+		m.VersionDescription,
+		migration.InProgress,
+		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		m.MID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	for _, step := range m.Steps {
+		mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+			step.MID,
+			step.Op,
+			step.MDID,
+			step.Name,
+			step.Forward,
+			step.Backward,
+			step.Output,
+			step.Status,
+			"",
+			step.Safe,
+			step.Operations,
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			step.TableName,
+			step.SID,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+
+	// The applied step is skipped
+	mgmtDB.MetadataGet(
+		4,
+		colMd.ToDBRow(),
+		false,
+	)
+
+	// The failed step is retried
+	mgmtDB.MetadataGet(
+		5,
+		ageMd.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+		failedStep.MID,
+		failedStep.Op,
+		failedStep.MDID,
+		failedStep.Name,
+		failedStep.Forward,
+		failedStep.Backward,
+		failedStep.Output,
+		migration.InProgress,
+		"",
+		failedStep.Safe,
+		failedStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		failedStep.TableName,
+		failedStep.SID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// Expect only the failed step to be executed HERE
+
+	projectDB.ExpectExec(test.DBQueryMock{
+		Type:   test.ExecCmd,
+		Query:  failedStep.Forward,
+		Result: sqlmock.NewResult(1, 1),
+	})
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// Update the Management DB with the result of the migration
+
+	mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+		failedStep.MID,
+		failedStep.Op,
+		failedStep.MDID,
+		failedStep.Name,
+		failedStep.Forward,
+		failedStep.Backward,
+		"Row(s) Affected: 1",
+		migration.Complete,
+		"",
+		failedStep.Safe,
+		failedStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		failedStep.TableName,
+		failedStep.SID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mgmtDB.MetadataGet(
+		5,
+		ageMd.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.Mock.ExpectExec("update `metadata`").WithArgs(
+		ageMd.DB,
+		ageMd.PropertyID,
+		ageMd.ParentID,
+		ageMd.Type,
+		ageMd.Name,
+		true,
+		ageMd.MDID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mgmtDB.Mock.ExpectExec("update `migration`").WithArgs(
+		m.DB,
+		testConfig.Project.Name,
+		testConfig.Project.Git.Version,
+		m.VersionTimestamp,
+		m.VersionDescription,
+		migration.Complete,
+		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		m.MID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+		appliedStep.MID,
+		appliedStep.Op,
+		appliedStep.MDID,
+		appliedStep.Name,
+		appliedStep.Forward,
+		appliedStep.Backward,
+		appliedStep.Output,
+		migration.Complete,
+		"",
+		appliedStep.Safe,
+		appliedStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		appliedStep.TableName,
+		appliedStep.SID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+		failedStep.MID,
+		failedStep.Op,
+		failedStep.MDID,
+		failedStep.Name,
+		failedStep.Forward,
+		failedStep.Backward,
+		"Row(s) Affected: 1",
+		migration.Complete,
+		"",
+		failedStep.Safe,
+		failedStep.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		failedStep.TableName,
+		failedStep.SID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
+	err = exec.Exec(exec.Options{
+		MID:         mid,
+		Dryrun:      dryrun,
+		PTODisabled: PTODisabled,
+		Resume:      resume,
+	})
+
+	if err != nil {
+		t.Errorf("%s failed with error: %v", testName, err)
+		return
+	}
+
+	projectDB.ExpectionsMet(testName, t)
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestExecRecover(t *testing.T) {
+	testName := "TestExecRecover"
+
+	util.LogAlert(testName)
+	var err error
+	var projectDB test.ProjectDB
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	testConfig := test.GetTestConfig()
+
+	// An interrupted migration
+	step := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     4,
+		Name:     "address",
+		Forward:  "ALTER TABLE `unittestproject_dogs` ADD COLUMN `address` varchar(128) NOT NULL;",
+		Backward: "ALTER TABLE `unittestproject_dogs` DROP COLUMN `address`;",
+		Status:   migration.InProgress,
+	}
+
+	m := migration.Migration{
+		MID:              1,
+		DB:               1,
+		Project:          testConfig.Project.Name,
+		Version:          testConfig.Project.Git.Version,
+		VersionTimestamp: "2016-07-12 12:04:05",
+		Status:           migration.InProgress,
+		Timestamp:        mysql.GetTimeNow(),
+		Steps: []migration.Step{
+			step,
+		},
+	}
+
+	projectDB, err = test.CreateProjectDB(testName, t)
+
+	if err == nil {
+		exec.SetProjectDB(projectDB.Db)
+	} else {
+		t.Errorf("%s failed to setup the Project DB with error: %v", testName, err)
+		return
+	}
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+
+	if err == nil {
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+		migration.Setup(mgmtDB.Db, 1)
+		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
+	} else {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+
+	// No other process holds the Migration Lock
+	mgmtDB.LockAcquire(1, m.MID)
+
+	mgmtDB.MigrationGet(
+		1,
+		m.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.MigrationStepGet(
+		1,
+		step.ToDBRow(),
+		false,
+	)
+
+	// Nothing is still running against the table of the InProgress step
+	projectDB.Mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM information_schema.PROCESSLIST WHERE ID != CONNECTION_ID() AND (INFO LIKE ? OR INFO LIKE ? OR INFO LIKE ?)")).
+		WithArgs(
+			"ALTER TABLE `unittestproject\\_dogs` ADD COLUMN `address` varchar(128) NOT NULL%",
+			"%`\\_unittestproject\\_dogs\\_new`%",
+			"%`\\_unittestproject\\_dogs\\_gho`%",
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(0))
+
+	mgmtDB.Mock.ExpectExec("update `migration`").WithArgs(
+		m.DB,
+		testConfig.Project.Name,
+		testConfig.Project.Git.Version,
+		m.VersionTimestamp,
+		m.VersionDescription,
+		migration.Failed,
+		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		m.MID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+		step.MID,
+		step.Op,
+		step.MDID,
+		step.Name,
+		step.Forward,
+		step.Backward,
+		"Interrupted: Recovered from a stale InProgress state",
+		migration.Failed,
+		"",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
+		step.SID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mgmtDB.LockRelease(1)
+
+	err = exec.Recover(m.MID)
+
+	if err != nil {
+		t.Errorf("%s failed with error: %v", testName, err)
+		return
+	}
+
+	projectDB.ExpectionsMet(testName, t)
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestExecRecoverLocked(t *testing.T) {
+	testName := "TestExecRecoverLocked"
+
+	util.LogAlert(testName)
+	var err error
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	testConfig := test.GetTestConfig()
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+
+	if err == nil {
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+		migration.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
+	} else {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+
+	// The Migration is still being applied by a live process
+	mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `migration_lock`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("UPDATE `migration_lock`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mgmtDB.ExpectQuery(test.DBQueryMock{
+		Query:   "FROM `migration_lock` WHERE `name` = 'target_database:1'",
+		Columns: []string{"name", "host", "pid", "mid", "acquired", "heartbeat", "age"},
+		Rows: []test.DBRow{
+			{"target_database:1", "runner-2", 4321, 1, "2016-07-12 12:04:05", "2016-07-12 12:04:35", 5},
+		},
+	})
+
+	err = exec.Recover(1)
+
+	if err == nil {
+		t.Errorf("%s FAILED. The Migration was recovered while the Migration Lock was held", testName)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestExecRollbackOnFailure(t *testing.T) {
+	testName := "TestExecRollbackOnFailure"
+
+	util.LogAlert(testName)
+	var err error
+	var projectDB test.ProjectDB
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	////////////////////////////////////////////////////////
+	// Configure testing data
+	//
+
+	testConfig := test.GetTestConfig()
+	dogsAddTbl := testdata.GetTableAddressDogs()
+
+	// The Table created by the first step
+	catsMd := metadata.Metadata{
+		MDID:       6,
+		DB:         1,
+		PropertyID: "cats",
+		Name:       "cats",
+		Type:       "Table",
+	}
+
+	// The Column added by the second step
+	colMd := dogsAddTbl.Columns[1].Metadata
+	colMd.MDID = 4
+
+	// The Column added by the step which fails
+	ageMd := metadata.Metadata{
+		MDID:       5,
+		DB:         1,
+		PropertyID: "age",
+		ParentID:   "dogs",
+		Name:       "age",
+		Type:       "Column",
+	}
+
+	// Migration id
+	mid := int64(1)
+
+	tableStep := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     6,
+		Name:     "cats",
+		Forward:  "CREATE TABLE `cats` (`id` int(11) NOT NULL);",
+		Backward: "DROP TABLE `cats`;",
+		Status:   migration.Approved,
+	}
+
+	appliedStep := migration.Step{
+		SID:      2,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     4,
+		Name:     "address",
+		Forward:  "ALTER TABLE `unittestproject_dogs` ADD COLUMN `address` varchar(128) NOT NULL;",
+		Backward: "ALTER TABLE `unittestproject_dogs` DROP COLUMN `address`;",
+		Status:   migration.Approved,
+	}
+
+	failingStep := migration.Step{
+		SID:      3,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     5,
+		Name:     "age",
+		Forward:  "ALTER TABLE `unittestproject_dogs` ADD COLUMN `age` int(11) NOT NULL;",
+		Backward: "ALTER TABLE `unittestproject_dogs` DROP COLUMN `age`;",
+		Status:   migration.Approved,
+	}
+
+	m := migration.Migration{
+		MID:                1,
+		DB:                 1,
+		Project:            testConfig.Project.Name,
+		Version:            testConfig.Project.Git.Version,
+		VersionTimestamp:   "2016-07-12 12:04:05",
+		VersionDescription: "An example git commit for unit testing",
+		Status:             migration.Approved,
+		Timestamp:          mysql.GetTimeNow(),
+		Steps: []migration.Step{
+			tableStep,
+			appliedStep,
+			failingStep,
+		},
+	}
+
+	applyErr := fmt.Errorf("Duplicate column name 'age'")
+
+	// Helper for the expected Migration and Migration Step updates
+	expectMigrationUpdate := func(status int) {
+		mgmtDB.Mock.ExpectExec("update `migration`").WithArgs(
+			m.DB,
+			testConfig.Project.Name,
+			testConfig.Project.Git.Version,
+			m.VersionTimestamp,
+			m.VersionDescription,
+			status,
+			"",
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			m.MID,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	expectStepUpdate := func(step migration.Step, output string, status int) {
+		mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+			step.MID,
+			step.Op,
+			step.MDID,
+			step.Name,
+			step.Forward,
+			step.Backward,
+			output,
+			status,
+			"",
+			step.Safe,
+			step.Operations,
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			step.TableName,
+			step.SID,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	expectMetadataUpdate := func(md metadata.Metadata, exists bool) {
+		mgmtDB.MetadataGet(int(md.MDID), md.ToDBRow(), false)
+		mgmtDB.Mock.ExpectExec("update `metadata`").WithArgs(
+			md.DB,
+			md.PropertyID,
+			md.ParentID,
+			md.Type,
+			md.Name,
+			exists,
+			md.MDID,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+
+	//
+	////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////
+	// Configure MySQL access for the management and project DBs
+	//
+
+	projectDB, err = test.CreateProjectDB(testName, t)
+
+	if err == nil {
+		exec.SetProjectDB(projectDB.Db)
+	} else {
+		t.Errorf("%s failed to setup the Project DB with error: %v", testName, err)
+		return
+	}
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+
+	if err == nil {
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+		migration.Setup(mgmtDB.Db, 1)
+		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
+	} else {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// Verify that the Migration can run
+
+	// Acquire the Migration Lock
+	mgmtDB.LockAcquire(1, mid)
+
+	mgmtDB.MigrationGet(1, m.ToDBRow(), false)
+	mgmtDB.MigrationStepsGet(
+		1,
+		[]test.DBRow{
+			tableStep.ToDBRow(),
+			appliedStep.ToDBRow(),
+			failingStep.ToDBRow(),
+		},
+		false,
+	)
+	mgmtDB.MigrationGetLatest(1, m.ToDBRow(), false)
+	mgmtDB.MigrationGetStatus(migration.InProgress, []test.DBRow{{}}, true)
+
+	// Set the Migration to InProgress
+	expectMigrationUpdate(migration.InProgress)
+	expectStepUpdate(tableStep, "", migration.Approved)
+	expectStepUpdate(appliedStep, "", migration.Approved)
+	expectStepUpdate(failingStep, "", migration.Approved)
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// The first step creates a Table, marking it and its fields as existing
+
+	mgmtDB.MetadataGet(6, catsMd.ToDBRow(), false)
+	expectStepUpdate(tableStep, "", migration.InProgress)
+	projectDB.Mock.ExpectExec(regexp.QuoteMeta(tableStep.Forward)).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectStepUpdate(tableStep, "Row(s) Affected: 1", migration.Complete)
+	expectMetadataUpdate(catsMd, true)
+	mgmtDB.MetadataSetTableExists(catsMd.Name, 1)
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// The second step is applied
+
+	mgmtDB.MetadataGet(4, colMd.ToDBRow(), false)
+	expectStepUpdate(appliedStep, "", migration.InProgress)
+	projectDB.Mock.ExpectExec(regexp.QuoteMeta(appliedStep.Forward)).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectStepUpdate(appliedStep, "Row(s) Affected: 1", migration.Complete)
+	expectMetadataUpdate(colMd, true)
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// The third step fails
+
+	failReason := fmt.Sprintf("Failed with Error: %v", applyErr)
+
+	mgmtDB.MetadataGet(5, ageMd.ToDBRow(), false)
+	expectStepUpdate(failingStep, "", migration.InProgress)
+	projectDB.Mock.ExpectExec(regexp.QuoteMeta(failingStep.Forward)).WillReturnError(applyErr)
+	expectStepUpdate(failingStep, failReason, migration.Failed)
+
+	// Record the Migration as failed
+	expectMigrationUpdate(migration.Failed)
+	expectStepUpdate(tableStep, "Row(s) Affected: 1", migration.Complete)
+	expectStepUpdate(appliedStep, "Row(s) Affected: 1", migration.Complete)
+	expectStepUpdate(failingStep, failReason, migration.Failed)
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// The applied steps are rolled back in reverse order
+
+	rollbackOutput := "Row(s) Affected: 1\nRollback: Row(s) Affected: 0"
+
+	mgmtDB.MetadataGet(4, colMd.ToDBRow(), false)
+	projectDB.Mock.ExpectExec(regexp.QuoteMeta(appliedStep.Backward)).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectStepUpdate(appliedStep, rollbackOutput, migration.Rollback)
+	expectMetadataUpdate(colMd, false)
+
+	// Dropping the Table again marks it and its fields as not existing
+	mgmtDB.MetadataGet(6, catsMd.ToDBRow(), false)
+	projectDB.Mock.ExpectExec(regexp.QuoteMeta(tableStep.Backward)).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectStepUpdate(tableStep, rollbackOutput, migration.Rollback)
+	expectMetadataUpdate(catsMd, false)
+	mgmtDB.MetadataMarkNonExistTable(catsMd.Name, 1)
+
+	// Record the final state of the Migration
+	expectMigrationUpdate(migration.Rollback)
+	expectStepUpdate(tableStep, rollbackOutput, migration.Rollback)
+	expectStepUpdate(appliedStep, rollbackOutput, migration.Rollback)
+	expectStepUpdate(failingStep, failReason, migration.Failed)
+
+	// Release the Migration Lock
+	mgmtDB.LockRelease(1)
+
+	err = exec.Exec(exec.Options{
+		MID:               mid,
+		PTODisabled:       true,
+		RollbackOnFailure: true,
+	})
+
+	if err == nil {
+		t.Errorf("%s FAILED. The failed step should return an error", testName)
+	}
+
+	projectDB.ExpectionsMet(testName, t)
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestExecFailDataLoss(t *testing.T) {
+	testName := "TestExecFailDataLoss"
+
+	util.LogAlert(testName)
+	var err error
+	var projectDB test.ProjectDB
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	////////////////////////////////////////////////////////
+	// Configure testing data
+	//
+
+	testConfig := test.GetTestConfig()
+
+	// Migration id
+	mid := int64(1)
+
+	dataLoss := "SELECT COUNT(*) FROM `unittestproject_dogs` WHERE (CHAR_LENGTH(`address`) > 32)"
+
+	// A Step which narrows a column, flagged as Safe to ensure that the data loss
+	// check alone requires the --allow-destructive flag
+	step := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Mod,
+		MDID:     4,
+		Name:     "address",
+		Forward:  "ALTER TABLE `unittestproject_dogs` MODIFY COLUMN `address` varchar(32) NOT NULL;",
+		Backward: "ALTER TABLE `unittestproject_dogs` MODIFY COLUMN `address` varchar(128) NOT NULL;",
+		Status:   migration.Approved,
+		Safe:     true,
+	}
+	err = step.SetOperations([]migration.StepOperation{
+		{
+			Op:       table.Mod,
+			MDID:     4,
+			Name:     "address",
+			DataLoss: dataLoss,
+		},
+	})
+	if err != nil {
+		t.Errorf("%s failed to record the Step operations with error: %v", testName, err)
+		return
+	}
+
+	m := migration.Migration{
+		MID:                1,
+		DB:                 1,
+		Project:            testConfig.Project.Name,
+		Version:            testConfig.Project.Git.Version,
+		VersionTimestamp:   "2016-07-12 12:04:05",
+		VersionDescription: "An example git commit for unit testing",
+		Status:             migration.Approved,
+		Timestamp:          mysql.GetTimeNow(),
+		Steps: []migration.Step{
+			step,
+		},
+	}
+
+	//
+	////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////
+	// Configure MySQL access for the management and project DBs
+	//
+
+	projectDB, err = test.CreateProjectDB(testName, t)
+
+	if err == nil {
+		exec.SetProjectDB(projectDB.Db)
+	} else {
+		t.Errorf("%s failed to setup the Project DB with error: %v", testName, err)
+		return
+	}
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+
+	if err == nil {
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+		migration.Setup(mgmtDB.Db, 1)
+		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
+	} else {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+
+	//
+	////////////////////////////////////////////////////////////
+
+	////////////////////////////////////////////////////////////
+	// Verify that the Migration is refused
+
+	mgmtDB.LockAcquire(1, mid)
+
+	mgmtDB.MigrationGet(
+		1,
+		m.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.MigrationStepGet(
+		1,
+		step.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.MigrationGetLatest(
+		1,
+		m.ToDBRow(),
+		false,
+	)
+
+	mgmtDB.MigrationGetStatus(
+		migration.InProgress,
+		[]test.DBRow{
+			{},
+		},
+		true,
+	)
+
+	// Count the rows which would be truncated by the Step
+	projectDB.ExpectQuery(test.DBQueryMock{
+		Query:   dataLoss,
+		Columns: []string{"COUNT(*)"},
+		Rows:    []test.DBRow{{3}},
+	})
+
+	mgmtDB.LockRelease(1)
+
+	//
+	////////////////////////////////////////////////////////////
+
+	err = exec.Exec(exec.Options{
+		MID:         mid,
+		PTODisabled: true,
+	})
+
+	if err == nil {
+		t.Errorf("%s SHOULD have FAILED but it did not", testName)
+		return
+	}
+
+	if !strings.Contains(err.Error(), "Data Loss: [3] row(s) would be truncated") {
+		t.Errorf("%s FAILED. The row count wasn't reported: %v", testName, err)
+	}
+
+	projectDB.ExpectionsMet(testName, t)
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestExecFailApproval(t *testing.T) {
+	testName := "TestExecFailApproval"
+
+	util.LogAlert(testName)
+	var err error
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	testConfig := test.GetTestConfig()
+	testConfig.Project.DB.Environment = "PROD"
+	testConfig.Project.Approval = config.Approval{
+		Policies: []config.ApprovalPolicy{
+			{
+				Env:       "PROD",
+				Approvers: 2,
+			},
+		},
+	}
+
+	step := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     1,
+		Name:     "dogs",
+		Forward:  "CREATE TABLE `dogs` (`id` int(11) NOT NULL);",
+		Backward: "DROP TABLE `dogs`;",
+		Status:   migration.Approved,
+	}
+
+	m := migration.Migration{
+		MID:              1,
+		DB:               1,
+		Project:          testConfig.Project.Name,
+		Version:          testConfig.Project.Git.Version,
+		VersionTimestamp: "2016-07-12 12:04:05",
+		Status:           migration.Approved,
+		VettedBy:         "alice",
+	}
+
+	// The approval policy is enforced even when the Migration is forced or rolled back
+	var tests = []struct {
+		Description string
+		Options     exec.Options
+	}{
+		{
+			Description: "Apply",
+			Options: exec.Options{
+				MID:         m.MID,
+				PTODisabled: true,
+			},
+		},
+		{
+			Description: "Force",
+			Options: exec.Options{
+				MID:         m.MID,
+				PTODisabled: true,
+				ForceCI:     true,
+			},
+		},
+		{
+			Description: "Rollback",
+			Options: exec.Options{
+				MID:         m.MID,
+				PTODisabled: true,
+				Rollback:    true,
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		mgmtDB, err = test.CreateManagementDB(testName, t)
+		if err != nil {
+			t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+			return
+		}
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+		migration.Setup(mgmtDB.Db, 1)
+		metadata.Setup(mgmtDB.Db, 1)
+		lock.Setup(mgmtDB.Db, 1)
+		approval.Setup(mgmtDB.Db)
+
+		mgmtDB.LockAcquire(1, m.MID)
+		mgmtDB.MigrationGet(1, m.ToDBRow(), false)
+		mgmtDB.MigrationStepGet(1, step.ToDBRow(), false)
+
+		// Only one of the two approvers required by PROD has approved it
+		mgmtDB.ApprovalGet(m.MID, []test.DBRow{
+			approval.Approval{AID: 1, MID: 1, Approver: "alice", Timestamp: "2016-07-12 12:04:05"}.ToDBRow(),
+		})
+
+		mgmtDB.LockRelease(1)
+
+		err = exec.Exec(tst.Options)
+		if err == nil || !strings.Contains(err.Error(), "requires 2 distinct approver(s), has 1") {
+			t.Errorf("%s: %s FAILED. Expected the approval policy to refuse the Migration. Error: %v", testName, tst.Description, err)
+		}
+
+		mgmtDB.ExpectionsMet(testName+": "+tst.Description, t)
+	}
+}
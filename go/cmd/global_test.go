@@ -121,6 +121,15 @@ func TestConfigReadFile(t *testing.T) {
 		false,
 	)
 
+	// the migration tables are up to date
+	mgmtDB.MigrationTableColumns(test.MigrationSchemaColumns)
+
+	// create if not exists migration_lock
+	mgmtDB.LockCreateTable()
+
+	// create if not exists migration_approval
+	mgmtDB.ApprovalCreateTable()
+
 	// Set the management DB
 	management.SetManagementDB(mgmtDB.Db)
 
@@ -203,7 +212,7 @@ func TestConfigReadURL(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, expectedConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, expectedConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	} else {
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/freneticmonkey/migrate/go/configsetup"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/urfave/cli"
+)
+
+// GetHistoryCommand Display the timings of the most recently applied migration steps
+func GetHistoryCommand() (setup cli.Command) {
+	setup = cli.Command{
+		Name:  "history",
+		Usage: "Display the timings of the most recently applied migration steps.",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "table",
+				Value: "",
+				Usage: "Only display the steps which changed the table",
+			},
+			cli.IntFlag{
+				Name:  "count",
+				Value: 20,
+				Usage: "The number of steps to display",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Display the history as JSON",
+			},
+		},
+		Action: func(ctx *cli.Context) (err error) {
+			var steps []migration.Step
+			var data []byte
+
+			// Parse global flags
+			parseGlobalFlags(ctx)
+
+			// Setup the management database and configuration settings
+			_, err = configsetup.ConfigureManagement()
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Configuration Load failed. Error: %v", err), 1)
+			}
+
+			steps, err = migration.LoadHistory(ctx.String("table"), int64(ctx.Int("count")))
+			if util.ErrorCheck(err) {
+				return cli.NewExitError("History failed. Unable to load the applied migration steps", 1)
+			}
+
+			if ctx.Bool("json") {
+				data, err = json.MarshalIndent(steps, "", "    ")
+				if util.ErrorCheck(err) {
+					return cli.NewExitError("History failed. Unable to format the history as JSON", 1)
+				}
+				fmt.Println(string(data))
+			} else {
+				migration.PrintHistory(steps)
+			}
+			return err
+		},
+	}
+	return setup
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/freneticmonkey/migrate/go/configsetup"
+	"github.com/freneticmonkey/migrate/go/lock"
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/urfave/cli"
+)
+
+// GetLockCommand Inspect or release the Migration Lock of the project database
+func GetLockCommand() (setup cli.Command) {
+	setup = cli.Command{
+		Name:  "lock",
+		Usage: "Inspect or release the migration lock of the project database.",
+		Subcommands: []cli.Command{
+			{
+				Name:  "status",
+				Usage: "Display the process holding the migration lock",
+				Action: func(ctx *cli.Context) (err error) {
+					var current lock.Lock
+					var held bool
+
+					// Parse global flags
+					parseGlobalFlags(ctx)
+
+					// Setup the management database and configuration settings
+					_, err = configsetup.ConfigureManagement()
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("Configuration Load failed. Error: %v", err), 1)
+					}
+
+					current, held, err = lock.Status()
+					if util.ErrorCheck(err) {
+						return cli.NewExitError("Unable to read the migration lock", 1)
+					}
+
+					if !held {
+						return cli.NewExitError(fmt.Sprintf("Migration Lock: [%s] is not held", lock.Name()), 0)
+					}
+
+					status := "held"
+					if current.Expired {
+						status = "expired"
+					}
+					return cli.NewExitError(fmt.Sprintf("Migration Lock: [%s] is %s by: [%s] applying Migration: [%d] since: [%s]. Last heartbeat: %d seconds ago", current.Name, status, current.Owner(), current.MID, current.Acquired, current.Age), 0)
+				},
+			},
+			{
+				Name:  "release",
+				Usage: "Release the expired migration lock of a process which has been killed",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "force",
+						Usage: "Release the migration lock even if its heartbeat hasn't expired.  Only use this if the process holding the lock has been killed.",
+					},
+				},
+				Action: func(ctx *cli.Context) (err error) {
+					var released bool
+
+					// Parse global flags
+					parseGlobalFlags(ctx)
+
+					// Setup the management database and configuration settings
+					_, err = configsetup.ConfigureManagement()
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("Configuration Load failed. Error: %v", err), 1)
+					}
+
+					released, err = lock.Release(ctx.Bool("force"))
+					if util.ErrorCheck(err) {
+						return cli.NewExitError(fmt.Sprintf("Unable to release the migration lock. Error: %v", err), 1)
+					}
+
+					if !released {
+						return cli.NewExitError(fmt.Sprintf("Migration Lock: [%s] is not held", lock.Name()), 0)
+					}
+					return cli.NewExitError(fmt.Sprintf("Migration Lock: [%s] released", lock.Name()), 0)
+				},
+			},
+		},
+	}
+	return setup
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/freneticmonkey/migrate/go/configsetup"
+	"github.com/freneticmonkey/migrate/go/exec"
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/urfave/cli"
+)
+
+// GetPlanCommand Annotate the steps of the migration indicated by the id flag for review
+func GetPlanCommand() (setup cli.Command) {
+	setup = cli.Command{
+		Name:  "plan",
+		Usage: "Display the cost of applying each step of a migration.",
+		Flags: []cli.Flag{
+			cli.IntFlag{
+				Name:  "id",
+				Value: 0,
+				Usage: "The id of the migration to be planned",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Display the plan as JSON",
+			},
+			cli.BoolFlag{
+				Name:  "pto-disabled",
+				Usage: "Plan the migration without using pt-online-schema-change.",
+			},
+		},
+		Action: func(ctx *cli.Context) (err error) {
+			var plan exec.Plan
+			var data []byte
+
+			// Parse global flags
+			parseGlobalFlags(ctx)
+
+			if !ctx.IsSet("id") || ctx.Int("id") <= 0 {
+				cli.ShowSubcommandHelp(ctx)
+				return cli.NewExitError("Plan failed. Unable to plan a migration without a Migration Id", 1)
+			}
+			mid := int64(ctx.Int("id"))
+
+			// Setup the management database and configuration settings
+			_, err = configsetup.ConfigureManagement()
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Configuration Load failed. Error: %v", err), 1)
+			}
+
+			plan, err = exec.NewPlan(mid, ctx.Bool("pto-disabled"))
+			if util.ErrorCheck(err) {
+				return cli.NewExitError(fmt.Sprintf("Plan failed. Unable to plan Migration: [%d]", mid), 1)
+			}
+
+			if ctx.Bool("json") {
+				data, err = json.MarshalIndent(plan, "", "    ")
+				if util.ErrorCheck(err) {
+					return cli.NewExitError("Plan failed. Unable to format the plan as JSON", 1)
+				}
+				fmt.Println(string(data))
+			} else {
+				fmt.Print(plan.String())
+			}
+			return err
+		},
+	}
+	return setup
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/configsetup"
+	"github.com/freneticmonkey/migrate/go/database"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/urfave/cli"
+)
+
+// GetPromoteCommand Promote a migration to the next database environment
+func GetPromoteCommand() (setup cli.Command) {
+	setup = cli.Command{
+		Name:  "promote",
+		Usage: "Create the migration of a Git version for the next database environment once it is complete in the environment the promotion rules require.",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "version",
+				Value: "",
+				Usage: "The Git version of the migration to promote",
+			},
+			cli.StringFlag{
+				Name:  "to",
+				Value: "",
+				Usage: "The database environment to promote the migration to. e.g. PROD",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			var conf config.Config
+			var err error
+
+			// Parse global flags
+			parseGlobalFlags(ctx)
+
+			// Setup the management database and configuration settings
+			conf, err = configsetup.ConfigureManagement()
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Configuration Load failed. Error: %v", err), 1)
+			}
+
+			return promote(ctx.String("version"), ctx.String("to"), conf)
+		},
+	}
+	return setup
+}
+
+// promote Copy the Migration of the version which is Complete in the environment required by
+// the promotion rules of the environment: to into a new Migration for its target database
+func promote(version string, to string, conf config.Config) *cli.ExitError {
+	var tdb database.TargetDatabase
+	var predecessor migration.Migration
+	var source *migration.Migration
+	var m migration.Migration
+	var err error
+
+	if len(version) == 0 {
+		return cli.NewExitError("Promote failed. A version is required", 1)
+	}
+
+	to = strings.ToUpper(to)
+	if !util.StringInArray(to, database.EnvNames) {
+		return cli.NewExitError(fmt.Sprintf("Promote failed. Unknown Environment: [%s]. Expected one of: %s", to, strings.Join(database.EnvNames, ", ")), 1)
+	}
+
+	if len(conf.Project.Promotion.RulesFor(to)) == 0 {
+		return cli.NewExitError(fmt.Sprintf("Promote failed. No promotion rules are configured for Environment: [%s]", to), 1)
+	}
+
+	predecessor, err = migration.CheckPromotion(conf.Project.Name, version, to, conf.Project.Promotion)
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Promote failed. %v", err), 1)
+	}
+
+	tdb, err = database.GetbyEnv(conf.Project.Name, to)
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Promote failed. Project: [%s] has no Target Database in Environment: [%s].  Run setup with the configuration of the environment first", conf.Project.Name, to), 1)
+	}
+
+	source, err = migration.Load(predecessor.MID)
+	if util.ErrorCheck(err) {
+		return cli.NewExitError(fmt.Sprintf("Promote failed. Unable to load Migration: [%d]", predecessor.MID), 1)
+	}
+
+	m, err = migration.Promote(source, tdb.DBID)
+	if util.ErrorCheck(err) {
+		return cli.NewExitError(fmt.Sprintf("Promote failed. Unable to create the Migration for Environment: [%s]. Error: %v", to, err), 1)
+	}
+
+	success := fmt.Sprintf("Promoted Migration: [%d] to Environment: [%s] as Migration: [%d]", source.MID, to, m.MID)
+	util.LogInfo(success)
+	return cli.NewExitError(success, 0)
+}
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/database"
+	"github.com/freneticmonkey/migrate/go/exec"
+	"github.com/freneticmonkey/migrate/go/lock"
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/test"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+func getPromotionConfig(env string) config.Config {
+	testConfig := test.GetTestConfig()
+	testConfig.Project.DB.Environment = env
+	testConfig.Project.Promotion = config.Promotion{
+		Rules: []config.PromotionRule{
+			{
+				Env:      "PROD",
+				Requires: "STAGE",
+			},
+		},
+	}
+	return testConfig
+}
+
+func TestPromote(t *testing.T) {
+	testName := "TestPromote"
+
+	util.LogAlert(testName)
+	var err error
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	testConfig := getPromotionConfig("STAGE")
+
+	md := metadata.Metadata{
+		MDID:       1,
+		DB:         1,
+		PropertyID: "tbl1",
+		Name:       "dogs",
+		Exists:     true,
+	}
+
+	step := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     1,
+		Name:     "dogs",
+		Forward:  "CREATE TABLE `dogs` (`id` int(11) NOT NULL);",
+		Backward: "DROP TABLE `dogs`;",
+		Status:   migration.Complete,
+
+		TableName: "dogs",
+	}
+
+	stage := migration.Migration{
+		MID:                1,
+		DB:                 1,
+		Project:            testConfig.Project.Name,
+		Version:            testConfig.Project.Git.Version,
+		VersionTimestamp:   "2016-07-12 12:04:05",
+		VersionDescription: "An example git commit for unit testing",
+		Status:             migration.Complete,
+		VettedBy:           "reviewer",
+	}
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+	if err != nil {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+	migration.Setup(mgmtDB.Db, 1)
+	metadata.Setup(mgmtDB.Db, 1)
+	database.Setup(mgmtDB.Db)
+
+	// The version is Complete in STAGE
+	mgmtDB.MigrationGetPredecessor(testConfig.Project.Name, stage.Version, "STAGE", stage.ToDBRow(), false)
+
+	// The target database of PROD
+	mgmtDB.DatabaseGetEnv(testConfig.Project.Name, "PROD", test.DBRow{2, testConfig.Project.Name, "project", "PROD"}, false)
+
+	mgmtDB.MigrationGet(1, stage.ToDBRow(), false)
+	mgmtDB.MigrationStepGet(1, step.ToDBRow(), false)
+
+	// The version hasn't been created for PROD yet
+	mgmtDB.MigrationGetVersionExists(stage.Version, test.DBRow{}, true)
+
+	// The Metadata of the Step is registered with PROD, where the Table doesn't exist yet
+	mgmtDB.MetadataGet(1, md.ToDBRow(), false)
+	mgmtDB.MetadataGetProperty(2, md.PropertyID, md.ParentID, test.DBRow{}, true)
+	mgmtDB.MetadataInsert(test.DBRow{2, md.PropertyID, md.ParentID, md.Type, md.Name, false}, 2, 1)
+
+	// The promoted Migration is linked to the Migration of STAGE and needs to be approved
+	mgmtDB.MigrationInsert(
+		test.DBRow{
+			2,
+			stage.Project,
+			stage.Version,
+			stage.VersionTimestamp,
+			stage.VersionDescription,
+			migration.Unapproved,
+			"",
+			"",
+			"",
+			stage.MID,
+		},
+		2,
+		1,
+	)
+
+	mgmtDB.MigrationInsertStep(
+		test.DBRow{
+			2,
+			step.Op,
+			2,
+			step.Name,
+			step.Forward,
+			step.Backward,
+			"",
+			migration.Unapproved,
+			"",
+			false,
+			"",
+			"",
+			"",
+			0,
+			"",
+			"",
+			0,
+			step.TableName,
+		},
+		2,
+		1,
+	)
+
+	result := promote(stage.Version, "prod", testConfig)
+	if result.ExitCode() > 0 {
+		t.Errorf("%s failed with error: %v", testName, result)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestPromoteIncomplete(t *testing.T) {
+	testName := "TestPromoteIncomplete"
+
+	util.LogAlert(testName)
+	var err error
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	testConfig := getPromotionConfig("STAGE")
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+	if err != nil {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+	migration.Setup(mgmtDB.Db, 1)
+
+	// The version hasn't completed in STAGE
+	mgmtDB.MigrationGetPredecessor(testConfig.Project.Name, testConfig.Project.Git.Version, "STAGE", test.DBRow{}, true)
+
+	result := promote(testConfig.Project.Git.Version, "PROD", testConfig)
+	if result.ExitCode() == 0 {
+		t.Errorf("%s FAILED. The version was promoted before it was Complete in STAGE", testName)
+	}
+
+	// Environments without promotion rules can't be promoted to
+	result = promote(testConfig.Project.Git.Version, "LT", testConfig)
+	if result.ExitCode() == 0 {
+		t.Errorf("%s FAILED. The version was promoted to an environment without promotion rules", testName)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestExecFailPromotion(t *testing.T) {
+	testName := "TestExecFailPromotion"
+
+	util.LogAlert(testName)
+	var err error
+	var mgmtDB test.ManagementDB
+
+	util.SetConfigTesting()
+
+	testConfig := getPromotionConfig("PROD")
+
+	step := migration.Step{
+		SID:      1,
+		MID:      1,
+		Op:       table.Add,
+		MDID:     1,
+		Name:     "dogs",
+		Forward:  "CREATE TABLE `dogs` (`id` int(11) NOT NULL);",
+		Backward: "DROP TABLE `dogs`;",
+		Status:   migration.Approved,
+	}
+
+	m := migration.Migration{
+		MID:              1,
+		DB:               1,
+		Project:          testConfig.Project.Name,
+		Version:          testConfig.Project.Git.Version,
+		VersionTimestamp: "2016-07-12 12:04:05",
+		Status:           migration.Approved,
+	}
+
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+	if err != nil {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+	exec.Setup(mgmtDB.Db, 1, testConfig.Project)
+	migration.Setup(mgmtDB.Db, 1)
+	metadata.Setup(mgmtDB.Db, 1)
+	lock.Setup(mgmtDB.Db, 1)
+
+	mgmtDB.LockAcquire(1, m.MID)
+	mgmtDB.MigrationGet(1, m.ToDBRow(), false)
+	mgmtDB.MigrationStepGet(1, step.ToDBRow(), false)
+
+	// The version hasn't completed in STAGE
+	mgmtDB.MigrationGetPredecessor(m.Project, m.Version, "STAGE", test.DBRow{}, true)
+
+	mgmtDB.LockRelease(1)
+
+	err = exec.Exec(exec.Options{
+		MID:         m.MID,
+		PTODisabled: true,
+	})
+	if err == nil {
+		t.Errorf("%s FAILED. The Migration was applied to PROD before it was Complete in STAGE", testName)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
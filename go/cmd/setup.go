@@ -167,8 +167,72 @@ func setupExistingDB(conf config.Config) *cli.ExitError {
 				util.LogInfof("Registering Table for migrations: %s", mysql.Schema[i].Name)
 			}
 
+			// Generate PropertyIds and YAML for all Database views
+			for i := 0; i < len(mysql.Views); i++ {
+				view := &mysql.Views[i]
+				view.GeneratePropertyIDs()
+
+				err = yaml.WriteView(path, *view)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Existing Database Setup FAILED.  Unable to create YAML View: %s due to error: %v", path, err), 1)
+				}
+
+				err = view.InsertMetadata()
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Existing Database Setup FAILED.  Unable to insert metadata for View: %s due to error: %v", view.Name, err), 1)
+				}
+				util.LogInfof("Registering View for migrations: %s", view.Name)
+			}
+
+			// Generate PropertyIds and YAML for all Database triggers, procedures and functions
+			for i := 0; i < len(mysql.Routines); i++ {
+				routine := &mysql.Routines[i]
+				routine.GeneratePropertyIDs()
+
+				err = yaml.WriteRoutine(path, *routine)
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Existing Database Setup FAILED.  Unable to create YAML %s: %s due to error: %v", routine.MetadataType(), path, err), 1)
+				}
+
+				err = routine.InsertMetadata()
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Existing Database Setup FAILED.  Unable to insert metadata for %s: %s due to error: %v", routine.MetadataType(), routine.Name, err), 1)
+				}
+				util.LogInfof("Registering %s for migrations: %s", routine.MetadataType(), routine.Name)
+			}
+
+			// Generate PropertyIds and YAML for all Database events
+			for i := 0; i < len(mysql.Events); i++ {
+				event := &mysql.Events[i]
+				event.GeneratePropertyIDs()
+
+				// Events are written alongside the tables of their Namespace
+				if len(event.Namespace.SchemaName) > 0 {
+					if conf.Project.Schema.WorkingRelative {
+						err = yaml.WriteEvent(util.WorkingPathAbs, *event)
+					} else {
+						err = yaml.WriteEvent("", *event)
+					}
+				} else {
+					err = yaml.WriteEvent(path, *event)
+				}
+
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Existing Database Setup FAILED.  Unable to create YAML Event: %s due to error: %v", path, err), 1)
+				}
+
+				err = event.InsertMetadata()
+				if err != nil {
+					return cli.NewExitError(fmt.Sprintf("Existing Database Setup FAILED.  Unable to insert metadata for Event: %s due to error: %v", event.Name, err), 1)
+				}
+				util.LogInfof("Registering Event for migrations: %s", event.Name)
+			}
+
 			util.VerboseOverrideSet(true)
 			util.LogOkf("Processed %d Tables", len(mysql.Schema))
+			util.LogOkf("Processed %d Views", len(mysql.Views))
+			util.LogOkf("Processed %d Routines", len(mysql.Routines))
+			util.LogOkf("Processed %d Events", len(mysql.Events))
 			util.LogOkf("Generated YAML definitions in path: %s", path)
 			return cli.NewExitError("Existing Database Setup Completed", 0)
 
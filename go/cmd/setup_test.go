@@ -50,6 +50,15 @@ func TestManagementSetup(t *testing.T) {
 		false,
 	)
 
+	// the migration tables are up to date
+	mgmtDB.MigrationTableColumns(test.MigrationSchemaColumns)
+
+	// create if not exists migration_lock
+	mgmtDB.LockCreateTable()
+
+	// create if not exists migration_approval
+	mgmtDB.ApprovalCreateTable()
+
 	// Set the management DB
 	management.SetManagementDB(mgmtDB.Db)
 
@@ -64,6 +73,95 @@ func TestManagementSetup(t *testing.T) {
 	testdata.Teardown()
 }
 
+func TestManagementSetupUpgrade(t *testing.T) {
+	var mgmtDB test.ManagementDB
+	var err error
+
+	testName := "TestManagementSetupUpgrade"
+
+	util.LogAlert(testName)
+
+	// Configuration
+	testConfig := test.GetTestConfig()
+
+	// Setup the mock Managment DB
+	mgmtDB, err = test.CreateManagementDB(testName, t)
+
+	// If we have the tables
+	mgmtDB.ShowTables(
+		[]test.DBRow{
+			{"metadata"},
+			{"migration"},
+			{"migration_steps"},
+			{"target_database"},
+		},
+		false,
+	)
+
+	mgmtDB.DatabaseGet(
+		testConfig.Project.Name,
+		testConfig.Project.DB.Database,
+		testConfig.Project.DB.Environment,
+		test.DBRow{1, "UnitTestProject", "project", "SANDBOX"},
+		false,
+	)
+
+	// The migration tables were created by an earlier release
+	mgmtDB.MigrationTableColumns(
+		[]test.DBRow{
+			{"migration", "mid", "bigint"},
+			{"migration", "db", "int"},
+			{"migration", "project", "varchar"},
+			{"migration", "version", "varchar"},
+			{"migration", "version_timestamp", "datetime"},
+			{"migration", "version_description", "text"},
+			{"migration", "status", "int"},
+			{"migration", "vetted_by", "varchar"},
+			{"migration", "timestamp", "datetime"},
+			{"migration_steps", "sid", "bigint"},
+			{"migration_steps", "mid", "bigint"},
+			{"migration_steps", "op", "int"},
+			{"migration_steps", "mdid", "bigint"},
+			{"migration_steps", "name", "varchar"},
+			{"migration_steps", "forward", "varchar"},
+			{"migration_steps", "backward", "varchar"},
+			{"migration_steps", "output", "text"},
+			{"migration_steps", "status", "int"},
+			{"migration_steps", "vetted_by", "varchar"},
+		},
+	)
+
+	// so the missing columns are added and the step statements widened
+	mgmtDB.MigrationUpgradeColumn("migration", "ADD", "applied_at")
+	mgmtDB.MigrationUpgradeColumn("migration", "ADD", "applied_by")
+	mgmtDB.MigrationUpgradeColumn("migration", "ADD", "promoted_from")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "MODIFY", "forward")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "MODIFY", "backward")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "safe")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "operations")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "started")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "finished")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "duration_ms")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "host")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "executor")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "rows_affected")
+	mgmtDB.MigrationUpgradeColumn("migration_steps", "ADD", "table_name")
+
+	mgmtDB.LockCreateTable()
+	mgmtDB.ApprovalCreateTable()
+
+	management.SetManagementDB(mgmtDB.Db)
+
+	err = management.Setup(testConfig)
+
+	if err != nil {
+		t.Errorf("%s FAILED with err: %v", testName, err)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+	testdata.Teardown()
+}
+
 func TestBuildSchema(t *testing.T) {
 	var mgmtDB test.ManagementDB
 	var err error
@@ -102,6 +200,12 @@ func TestBuildSchema(t *testing.T) {
 	// create if not exists target_database
 	mgmtDB.DatabaseCreateTable()
 
+	// create if not exists migration_lock
+	mgmtDB.LockCreateTable()
+
+	// create if not exists migration_approval
+	mgmtDB.ApprovalCreateTable()
+
 	// Set the management DB
 	management.SetManagementDB(mgmtDB.Db)
 
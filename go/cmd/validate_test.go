@@ -73,7 +73,7 @@ func TestValidate(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	}
@@ -208,7 +208,7 @@ func TestValidateMySQL(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	}
@@ -375,7 +375,7 @@ schemaTwo/*`
 
 	if err == nil {
 		// migration.Setup(mgmtDB.Db, 1)
-		exec.Setup(mgmtDB.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDB.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDB.Db, 1)
 		metadata.Setup(mgmtDB.Db, 1)
 	}
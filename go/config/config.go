@@ -1,6 +1,9 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Config struct {
 	Options     Options
@@ -52,6 +55,85 @@ type Project struct {
 	Generation Generation
 	Schema 	   Schema
 	Git        Git
+	Migration  Migration
+	Promotion  Promotion
+	Approval   Approval
+}
+
+// Approval Policies which the approvals of a Migration must satisfy before it can be applied
+type Approval struct {
+	// DBAs The approvers who can approve destructive Steps
+	DBAs     []string
+	Policies []ApprovalPolicy
+	// IdentityHeader The HTTP header containing the user authenticated by the proxy in front
+	// of the REST API, e.g. X-Forwarded-User.  When set, approvals are recorded against it
+	// instead of the unauthenticated vetted_by of the request.  It's also required to
+	// release the migration lock through the REST API.
+	IdentityHeader string
+}
+
+// ApprovalPolicy The approvals required by the Migrations of the Env environment
+type ApprovalPolicy struct {
+	Env string
+	// The number of distinct approvers required
+	Approvers int
+	// Destructive Steps require the approval of a DBA
+	DBAForDestructive bool
+	// Allow the author of the Git version to approve its Migration
+	SelfApproval bool
+}
+
+// PolicyFor Returns the combined policies of the environment, and false if no policy applies to it
+func (a Approval) PolicyFor(env string) (policy ApprovalPolicy, found bool) {
+	policy.Env = env
+	policy.SelfApproval = true
+	for _, p := range a.Policies {
+		if strings.EqualFold(p.Env, env) {
+			found = true
+			if p.Approvers > policy.Approvers {
+				policy.Approvers = p.Approvers
+			}
+			policy.DBAForDestructive = policy.DBAForDestructive || p.DBAForDestructive
+			policy.SelfApproval = policy.SelfApproval && p.SelfApproval
+		}
+	}
+	if !found {
+		return ApprovalPolicy{}, found
+	}
+	return policy, found
+}
+
+// IsDBA Returns true if the approver is one of the DBAs
+func (a Approval) IsDBA(approver string) bool {
+	for _, dba := range a.DBAs {
+		if strings.EqualFold(dba, approver) {
+			return true
+		}
+	}
+	return false
+}
+
+// Promotion Rules which a Git version must satisfy before its Migration can be
+// created for, or applied to, a database environment
+type Promotion struct {
+	Rules []PromotionRule
+}
+
+// PromotionRule Requires a Git version to be Complete in the Requires environment
+// before it can be migrated in the Env environment. e.g. Env: PROD Requires: STAGE
+type PromotionRule struct {
+	Env      string
+	Requires string
+}
+
+// RulesFor Returns the rules which apply to the environment
+func (p Promotion) RulesFor(env string) (rules []PromotionRule) {
+	for _, rule := range p.Rules {
+		if strings.EqualFold(rule.Env, env) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
 }
 
 type Schema struct {
@@ -59,6 +141,66 @@ type Schema struct {
 	Namespaces      []SchemaNamespace
 }
 
+type Migration struct {
+	// Online DDL strategy used to append ALGORITHM and LOCK clauses to
+	// ALTER TABLE statements: "" (none), "instant" or "inplace"
+	Algorithm string
+	// Executor used to apply ALTER TABLE statements: "native", "pt-osc" or "gh-ost".
+	// Defaults to "pt-osc"
+	Executor string
+	PTO       PTO
+	GhOst     GhOst
+	Preflight Preflight
+	Timeout   Timeout
+}
+
+// Timeout Limits how long the Go SQL driver waits for each step to be applied.
+// A timeout of 0 disables its limit.
+type Timeout struct {
+	// Seconds a step may run before its statement is cancelled with KILL QUERY
+	StepSeconds int64
+	// Seconds the session waits for metadata and row locks.  Defaults to StepSeconds
+	LockWaitSeconds int64
+}
+
+type PTO struct {
+	MaxLoad                string
+	CriticalLoad           string
+	ChunkSize              int
+	RecursionMethod        string
+	AlterForeignKeysMethod string
+}
+
+// Preflight Thresholds for the safety checks run against the project database
+// before each step is applied.  A threshold of 0 disables its check.
+type Preflight struct {
+	// Row count of the altered table
+	WarnRows int64
+	MaxRows  int64
+	// Data and index size of the altered table in MB
+	WarnSizeMB int64
+	MaxSizeMB  int64
+	// Transactions running for longer than this can block the metadata lock
+	MaxTransactionSeconds int64
+	// Replica lag
+	Replicas             []DB
+	MaxReplicaLagSeconds int64
+	// Free disk space of DataDir required as a multiple of the table size
+	// when the table is copied.  The free space is read from the file system
+	// of the host running migrate, so it's only checked when migrate runs on
+	// the database host.  Otherwise the step is warned about instead.
+	DataDir        string
+	FreeSpaceRatio float64
+}
+
+type GhOst struct {
+	MaxLoad       string
+	CriticalLoad  string
+	ChunkSize     int
+	MaxLagMillis  int
+	AllowOnMaster bool
+}
+
 type Git struct {
 	Name       string
 	Url        string
@@ -63,3 +63,13 @@ func GetbyProject(project string, name string, env string) (db TargetDatabase, e
 
 	return db, err
 }
+
+// GetbyEnv Get the target database of the project in the environment from the management db
+func GetbyEnv(project string, env string) (db TargetDatabase, err error) {
+	err = mgmtDb.SelectOne(&db, fmt.Sprintf("SELECT * FROM target_database WHERE project=\"%s\" AND env=\"%s\"", project, env))
+	if err != nil {
+		util.LogWarnf("Failed to find Target Database for Project: [%s] in Env: [%s]", project, env)
+	}
+
+	return db, err
+}
@@ -0,0 +1,41 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// credentialsPlaceholder Stands in for the path of the credentials file when a
+// command is only being displayed
+const credentialsPlaceholder = "<credentials>"
+
+// optionValue Quotes a value for a MySQL option file, escaping any characters
+// which would otherwise end or alter the value
+func optionValue(value string) string {
+	value = strings.Replace(value, "\\", "\\\\", -1)
+	value = strings.Replace(value, "\"", "\\\"", -1)
+	value = strings.Replace(value, "\n", "\\n", -1)
+	return fmt.Sprintf("\"%s\"", value)
+}
+
+// credentialsFile Writes the user and password of the database into a temporary
+// option file which is only readable by the current user.  This keeps the password
+// out of the arguments of the executor process, where it is visible to every user
+// of the host.  The caller must delete the file once the executor has finished.
+func credentialsFile(executor string, db config.DB) (path string, err error) {
+	lines := []string{
+		"[client]",
+		fmt.Sprintf("user=%s", optionValue(db.Username)),
+		fmt.Sprintf("password=%s", optionValue(db.Password)),
+	}
+
+	prefix := fmt.Sprintf("migrate-%s-", executor)
+
+	path, err = util.TempFile(prefix, []byte(strings.Join(lines, "\n")+"\n"))
+	util.ErrorCheckf(err, "Failed to write the credentials file for the %s executor", executor)
+
+	return path, err
+}
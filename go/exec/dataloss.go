@@ -0,0 +1,43 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// dataLossReport Counts the rows of the project database which would lose data when
+// the step narrows a column.  The report is empty if the step can't lose column data.
+func dataLossReport(step migration.Step) (report string, err error) {
+	var checks []string
+	var count int64
+
+	checks, err = step.DataLossChecks()
+	if err != nil || len(checks) == 0 {
+		return report, err
+	}
+
+	if _, err = ConnectProjectDB(false); err != nil {
+		return report, err
+	}
+
+	lines := []string{}
+	counted := map[string]bool{}
+	for _, check := range checks {
+		// Coalesced operations on the same column share a check
+		if counted[check] {
+			continue
+		}
+		counted[check] = true
+
+		count, err = projectDB.SelectInt(check)
+		if util.ErrorCheckf(err, "Unable to count the rows which would lose data in Step: [%d]", step.SID) {
+			return report, err
+		}
+		lines = append(lines, fmt.Sprintf("Data Loss: [%d] row(s) would be truncated: [%s]", count, check))
+	}
+
+	return strings.Join(lines, "\n"), err
+}
@@ -3,10 +3,13 @@ package exec
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/freneticmonkey/migrate/go/approval"
+	"github.com/freneticmonkey/migrate/go/lock"
 	"github.com/freneticmonkey/migrate/go/metadata"
 	"github.com/freneticmonkey/migrate/go/migration"
-	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/mysql"
 	"github.com/freneticmonkey/migrate/go/util"
 )
 
@@ -21,6 +24,8 @@ type Options struct {
 	Migration         *migration.Migration
 	Sandbox           bool
 	StepConfirm    	  bool
+	Resume            bool
+	RollbackOnFailure bool
 }
 
 // Exec Apply the migration to the project database.  The parmeters can be used to just execute a dryrun, force past
@@ -35,6 +40,8 @@ func Exec(options Options) (err error) {
 	ptodisbled := options.PTODisabled
 	allowDestructive := options.AllowDestructive
 	stepConfirm := options.StepConfirm
+	resume := options.Resume
+	rollbackOnFailure := options.RollbackOnFailure && !rollback
 
 	m := options.Migration
 
@@ -42,6 +49,8 @@ func Exec(options Options) (err error) {
 	var output string
 	var success bool
 	var action string
+	var executor Executor
+	var findings Findings
 
 	// If we are in the sandbox, rollback migrations are allowed.
 	if sandbox {
@@ -49,6 +58,17 @@ func Exec(options Options) (err error) {
 	}
 
 
+	// Hold the Migration Lock of the target database while the migration is validated and
+	// applied, so that concurrent executions can't apply a migration at the same time.
+	var lease *lock.Lease
+	if !dryrun && !sandbox {
+		lease, err = lock.Acquire(mid)
+		if util.ErrorCheck(err) {
+			return err
+		}
+		defer lease.Release()
+	}
+
 	// If a Migration ID was supplied in the Migration Options, then attempt to load from the DB
 	if mid > 0 {
 		m, err = migration.Load(mid)
@@ -59,11 +79,49 @@ func Exec(options Options) (err error) {
 		return fmt.Errorf("Migration failed.  Invalid Migration Id: [%d]", mid)
 	}
 
+	// Ensure that the configured executor is supported before applying any steps
+	if !ptodisbled {
+		if _, err = NewExecutor(projectConfig.Migration.Executor); util.ErrorCheck(err) {
+			return err
+		}
+	}
+
+	if resume {
+		if rollback {
+			return fmt.Errorf("Migration: [%d] cannot be resumed as a rollback", mid)
+		}
+		if m.Status == migration.InProgress {
+			return fmt.Errorf("Migration: [%d] is still InProgress.  Use --recover to clear a stale InProgress state before resuming it", mid)
+		}
+	}
+
+	// Ensure that the version has been promoted through the environments required by the promotion rules
+	if !rollback && !sandbox {
+		_, err = migration.CheckPromotion(m.Project, m.Version, projectConfig.DB.Environment, projectConfig.Promotion)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Ensure that the Migration has the approvals required by the approval policy of the environment.
+	// Unlike the Approved status, the policy can't be bypassed by forcing or rolling back the Migration.
+	if !sandbox && !dryrun {
+		var evaluation approval.Evaluation
+		evaluation, err = approval.Evaluate(m, projectConfig.DB.Environment, projectConfig.Approval)
+		if util.ErrorCheckf(err, "Unable to evaluate the approval policy of Migration: [%d]", mid) {
+			return err
+		}
+		if err = evaluation.Error(); err != nil {
+			util.LogError(err.Error())
+			return err
+		}
+	}
+
 	// TODO: Update the Migration state at the end of the Migration!!!!
 
 	// has the migration been approved for migration or if it is being forced
 	// Assumes that this migration hasn't already been applied since the Load statement above
-	if m.Status == migration.Approved || force {
+	if m.Status == migration.Approved || force || (resume && m.Status == migration.Failed) {
 
 		// Validate the migration
 		var isLatest bool
@@ -113,15 +171,30 @@ func Exec(options Options) (err error) {
 		// Check the migration for destructive changes, and verify if they are allowed.
 		unapprovedDestructive := false
 		destructiveChanges := []string{}
+		dataLoss := map[int64]string{}
 		for _, step := range m.Steps {
+			change := step.Forward
+
+			// Count the rows which would lose data when the step narrows a column
+			if !rollback && !sandbox && !isApplied(step) {
+				report, lossErr := dataLossReport(step)
+				if lossErr != nil {
+					return lossErr
+				}
+				if len(report) > 0 {
+					dataLoss[step.SID] = report
+					change = fmt.Sprintf("%s\n\t%s", change, strings.Replace(report, "\n", "\n\t", -1))
+				}
+			}
+
 			// If Destructive
-			if step.Op != table.Add {
-				destructiveChanges = append(destructiveChanges, step.Forward)
+			if step.IsDestructive() || len(dataLoss[step.SID]) > 0 {
+				destructiveChanges = append(destructiveChanges, change)
 
 				// If not destruction not approved - fail
 				if !options.AllowDestructive {
 					unapprovedDestructive = true
-					failReason = fmt.Sprintf("Migration: [%d] cannot be applied because it contains destructive change(s): [%s] without use of the --allow-destructive flag", mid, step.Forward)
+					failReason = fmt.Sprintf("Migration: [%d] cannot be applied because it contains destructive change(s): [%s] without use of the --allow-destructive flag", mid, change)
 					break
 				}
 			}
@@ -140,6 +213,24 @@ func Exec(options Options) (err error) {
 			}
 		}
 
+		// When resuming, verify that the steps which have already been applied match the live schema
+		unverifiedSteps := false
+		if resume {
+			for _, step := range m.Steps {
+				if isApplied(step) {
+					verified, reason, verifyErr := verifyStep(step)
+					if verifyErr != nil {
+						return verifyErr
+					}
+					if !verified {
+						unverifiedSteps = true
+						failReason = fmt.Sprintf("Migration: [%d] cannot be resumed because the applied Step: [%d] doesn't match the live schema. %s", mid, step.SID, reason)
+						break
+					}
+				}
+			}
+		}
+
 		// We assume that everything is ok by default
 		migrationCanExecute := true
 
@@ -159,6 +250,11 @@ func Exec(options Options) (err error) {
 			migrationCanExecute = false
 		}
 
+		// If the applied steps don't match the live schema
+		if unverifiedSteps {
+			migrationCanExecute = false
+		}
+
 		// If there's another migration already running
 		if migrationRunning {
 			migrationCanExecute = false
@@ -178,30 +274,38 @@ func Exec(options Options) (err error) {
 				}
 			}
 
+			// The index of the step which failed to apply
+			failedStep := -1
+
 			// for each step in the migration
 			for i := 0; i < len(m.Steps); i++ {
 				step := m.Steps[i]
 
-				var md *metadata.Metadata
-				isDestructive := (step.Op != table.Add)
+				// Halt if another process has taken over the Migration Lock
+				if lease != nil && lease.Lost() {
+					success = false
+					err = fmt.Errorf("Migration: [%d] halted before Step: [%d] because the Migration Lock was lost", mid, step.SID)
+					util.LogError(err.Error())
 
-				// check if ptodisabled is true
-				usePTO := !ptodisbled
+					// Record why the Migration halted so that it can be resumed
+					if !dryrun && !m.Sandbox {
+						if haltErr := haltMigration(m, i, err); haltErr != nil {
+							return haltErr
+						}
+					}
+					break
+				}
+
+				var md *metadata.Metadata
+				isDestructive := step.IsDestructive() || len(dataLoss[step.SID]) > 0
 
 				// Check if create or drop table.
 				md, err = metadata.Load(step.MDID)
 
 				if !util.ErrorCheckf(err, "The Metadata: [%d] for Step: [%d] couldn't be loaded from the Management DB", step.MDID, step.SID) {
 
-					// if PTO can be used, and this migration is changing a table and
-					// the modification is either a CREATE OR DROP TABLE.
-					if usePTO && md.IsTable() && (step.Op == table.Add || step.Op == table.Del) {
-						// if so, use the regular go sql driver to execute the migration
-						usePTO = false
-					}
-
-					// If the Step has been approved to be applied
-					if step.Status == migration.Approved || options.Sandbox {
+					// If the Step has been approved to be applied, or failed and is being resumed
+					if step.Status == migration.Approved || options.Sandbox || (resume && step.Status == migration.Failed) {
 
 						success = false
 						statement = step.Forward
@@ -211,20 +315,35 @@ func Exec(options Options) (err error) {
 							statement = step.Backward
 						}
 
+						// Select the executor for the database and table changed by the statement
+						target := NewTarget(statement)
+						executor, err = stepExecutor(ptodisbled, md, step.Op, target)
+						if util.ErrorCheckf(err, "Migration Step: [%d] No executor available", step.SID) {
+							return err
+						}
+
 						if dryrun {
 
 							if !allowDestructive && isDestructive {
 								util.LogAttentionf("(DRYRUN) Skipping Migration Step: [%d]: Unapproved destructive change", step.SID)
 							} else {
 								// execute a dryrun of the migration step
-								if usePTO {
-									output, err = executePTO(statement, dryrun)
-								} else {
-									// otherwise use the regular go sql driver
-									output, err = ExecuteSQL(statement, dryrun)
-									util.ErrorCheckf(err, "Migration Step: ALTER TABLE Failed: [%v]", err)
+								output, _, err = executor.Execute(target, dryrun)
+								util.ErrorCheckf(err, "Migration Step: ALTER TABLE Failed: [%v]", err)
 
+								if algorithm := mysql.StatementAlgorithm(statement); len(algorithm) > 0 {
+									output = fmt.Sprintf("Algorithm: %s\n%s", algorithm, output)
 								}
+
+								// Report the pre-flight checks of the step
+								findings, preflightErr := preflightStep(target, copiesTable(executor, statement))
+								if len(findings) > 0 {
+									output = fmt.Sprintf("%s\n%s", findings, output)
+								}
+								if len(dataLoss[step.SID]) > 0 {
+									output = fmt.Sprintf("%s\n%s", dataLoss[step.SID], output)
+								}
+								util.ErrorCheckf(preflightErr, "Migration Step: [%d] Pre-flight checks failed", step.SID)
 								util.LogAttentionf("(DRYRUN) Migration Step: [%d]\n%s", step.SID, output)
 							}
 
@@ -268,22 +387,46 @@ func Exec(options Options) (err error) {
 
 									// Indicate that the step is going to be applied
 									m.Steps[i].Status = migration.InProgress
+									m.Steps[i].Started = mysql.GetTimeNow()
+									m.Steps[i].Finished = ""
+									m.Steps[i].Host, _ = lock.Owner()
+									m.Steps[i].Executor = executor.Name()
+									m.Steps[i].RowsAffected = 0
 									err = m.Steps[i].Update()
 									if util.ErrorCheck(err) {
 										return err
 									}
 
+									// Check the project database for risks before applying the step
+									findings, err = preflightStep(target, copiesTable(executor, statement))
+									if err == nil && findings.Refused() {
+										err = fmt.Errorf("Pre-flight checks refused Migration Step: [%d]", step.SID)
+									}
+
 									// execute the migration
-									if usePTO {
-										output, err = executePTO(statement, dryrun)
-									} else {
-										// otherwise use the regular go sql driver
-										output, err = ExecuteSQL(statement, dryrun)
+									started := time.Now()
+									if err == nil {
+										util.LogInfof("Migration Step: [%d] Executor: [%s]", step.SID, executor.Name())
+										output, m.Steps[i].RowsAffected, err = executor.Execute(target, dryrun)
 										util.ErrorCheckf(err, "Migration Step: ALTER TABLE Failed: [%v]", err)
 									}
+									m.Steps[i].Finished = mysql.GetTimeNow()
+									m.Steps[i].DurationMS = int64(time.Since(started) / time.Millisecond)
+
 
 									if !util.ErrorCheckf(err, "Migration Step: [%d] Apply Failed with ERROR: ", output) {
-										// Record the result into the step table
+										// Record the result and the online DDL algorithm into the step table
+										if algorithm := mysql.StatementAlgorithm(statement); len(algorithm) > 0 {
+											output = fmt.Sprintf("Algorithm: %s\n%s", algorithm, output)
+										}
+										// Record the pre-flight findings into the step table
+										if len(findings) > 0 {
+											output = fmt.Sprintf("%s\n%s", findings, output)
+										}
+										// Record the rows which were truncated by narrowing a column
+										if len(dataLoss[step.SID]) > 0 {
+											output = fmt.Sprintf("%s\n%s", dataLoss[step.SID], output)
+										}
 										m.Steps[i].Output = output
 
 										if force {
@@ -299,11 +442,19 @@ func Exec(options Options) (err error) {
 
 									} else {
 
-										// Record the step failure into the DB
-										failReason = fmt.Sprintf("Failed with Error: %v", err)
+										// Record the step failure into the DB.  Timeouts are reported distinctly
+										// so that the step can be retried when the table is quieter.
+										if _, timedOut := err.(TimeoutError); timedOut {
+											failReason = fmt.Sprintf("Timed Out: %v", err)
+										} else {
+											failReason = fmt.Sprintf("Failed with Error: %v", err)
+										}
 										m.Steps[i].Output = failReason
+										if len(findings) > 0 {
+											m.Steps[i].Output = fmt.Sprintf("%s\n%s", findings, failReason)
+										}
 										m.Steps[i].Status = migration.Failed
-										err = step.Update()
+										err = m.Steps[i].Update()
 
 										if err != nil {
 											return err
@@ -322,6 +473,7 @@ func Exec(options Options) (err error) {
 										// Format an error message
 										err = fmt.Errorf("Migration with ID: [%d] failed during apply. Reason: %s", m.MID, failReason)
 
+										failedStep = i
 										success = false
 										break
 									}
@@ -345,6 +497,12 @@ func Exec(options Options) (err error) {
 							}
 
 						}
+					} else if resume && isApplied(step) {
+						util.LogInfof("Migration Step: [%d] has already been applied. Skipping.", step.SID)
+
+						// An applied step is still successful
+						success = true
+
 					} else {
 						util.LogWarnf("Migration Step: [%d] isn't approved to be applied. Skipping.", step.SID)
 
@@ -362,6 +520,23 @@ func Exec(options Options) (err error) {
 				// Finished Migration Step
 			}
 
+			// Undo the steps which were applied before the failed step
+			if rollbackOnFailure && failedStep >= 0 {
+				rollbackErr := rollbackApplied(m, failedStep, ptodisbled)
+
+				if rollbackErr == nil {
+					m.Status = migration.Rollback
+					util.LogWarnf("Migration: [%d] was rolled back after Step: [%d] failed", m.MID, m.Steps[failedStep].SID)
+				} else {
+					err = fmt.Errorf("%v. Rollback failed: %v", err, rollbackErr)
+				}
+
+				// Record the final state of the migration
+				if updateErr := m.Update(); updateErr != nil {
+					return updateErr
+				}
+			}
+
 			// Store success in the database
 			if success {
 				if !dryrun {
@@ -372,6 +547,8 @@ func Exec(options Options) (err error) {
 					} else {
 						m.Status = migration.Complete
 					}
+					m.AppliedAt = mysql.GetTimeNow()
+					m.AppliedBy = appliedBy()
 					err = m.Update()
 					if err != nil {
 						return err
@@ -0,0 +1,95 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/mysql"
+	"github.com/freneticmonkey/migrate/go/table"
+)
+
+// Schema change executors
+const (
+	// ExecutorNative Apply statements using the regular go sql driver
+	ExecutorNative = "native"
+	// ExecutorPTO Apply ALTER TABLE statements using pt-online-schema-change
+	ExecutorPTO = "pt-osc"
+	// ExecutorGhOst Apply ALTER TABLE statements using gh-ost
+	ExecutorGhOst = "gh-ost"
+)
+
+// Target The database and table changed by a Migration Step statement
+type Target struct {
+	Database  string
+	Table     string
+	Statement string
+	// Clause The alter specification of an ALTER TABLE statement
+	Clause string
+}
+
+// Executor Applies a Migration Step statement to the project database.  Executors
+// which can't count the rows affected by the statement report 0 rows.
+type Executor interface {
+	Name() string
+	Execute(target Target, dryrun bool) (output string, rowsAffected int64, err error)
+}
+
+// NativeExecutor Applies statements using the regular go sql driver
+type NativeExecutor struct{}
+
+// Name Returns the name of the executor
+func (e NativeExecutor) Name() string {
+	return ExecutorNative
+}
+
+// Execute Execute the statement in the project database
+func (e NativeExecutor) Execute(target Target, dryrun bool) (output string, rowsAffected int64, err error) {
+	return executeSQL(target.Statement, dryrun)
+}
+
+// NewExecutor Returns the executor configured by name.  An empty name returns
+// the pt-online-schema-change executor.
+func NewExecutor(name string) (executor Executor, err error) {
+	switch strings.ToLower(name) {
+	case ExecutorNative:
+		executor = NativeExecutor{}
+	case "", ExecutorPTO:
+		executor = PTOExecutor{
+			DB:      projectConfig.DB,
+			Options: projectConfig.Migration.PTO,
+		}
+	case ExecutorGhOst:
+		executor = GhOstExecutor{
+			DB:      projectConfig.DB,
+			Options: projectConfig.Migration.GhOst,
+		}
+	default:
+		err = fmt.Errorf("Unsupported Migration executor: [%s]", name)
+	}
+	return executor, err
+}
+
+// NewTarget Returns the project database and table changed by the statement
+func NewTarget(statement string) Target {
+	target := Target{
+		Database:  projectConfig.DB.Database,
+		Statement: statement,
+	}
+	target.Table, target.Clause, _ = mysql.AlterTableClause(statement)
+	return target
+}
+
+// stepExecutor Returns the executor used to apply a step's statement.  Online schema
+// change tools can only apply ALTER TABLE statements, so creating and dropping tables,
+// views and routines are always applied with the regular go sql driver, as are
+// table renames and partitioning changes.
+func stepExecutor(ptoDisabled bool, md *metadata.Metadata, op int, target Target) (Executor, error) {
+	if ptoDisabled || len(target.Clause) == 0 {
+		return NativeExecutor{}, nil
+	}
+	if md.IsTable() && (op == table.Add || op == table.Del) {
+		return NativeExecutor{}, nil
+	}
+	return NewExecutor(projectConfig.Migration.Executor)
+}
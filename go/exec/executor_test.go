@@ -0,0 +1,206 @@
+package exec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/test"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+func TestExecutors(t *testing.T) {
+	ptoCredentials := filepath.Join(os.TempDir(), "migrate-pt-osc-")
+	ghostCredentials := filepath.Join(os.TempDir(), "migrate-gh-ost-")
+
+	statement := "ALTER TABLE `dogs` ADD COLUMN `name` varchar(64) NOT NULL, ALGORITHM=INSTANT;"
+	clause := "ADD COLUMN `name` varchar(64) NOT NULL"
+
+	var tests = []struct {
+		Description    string
+		Migration      config.Migration
+		ExpectedName   string
+		ExpectedCmd    string
+		ExpectedParams [][]string
+	}{
+		{
+			Description:  "Default pt-online-schema-change",
+			ExpectedName: ExecutorPTO,
+			ExpectedCmd:  "pt-online-schema-change",
+			ExpectedParams: [][]string{
+				{
+					"--defaults-file", ptoCredentials,
+					"h=127.0.0.1,P=3306,D=project,t=dogs",
+					"--alter", clause,
+					"--critical-load", "Threads_running=500",
+					"--dry-run",
+				},
+				{
+					"--defaults-file", ptoCredentials,
+					"h=127.0.0.1,P=3306,D=project,t=dogs",
+					"--alter", clause,
+					"--critical-load", "Threads_running=500",
+					"--execute",
+				},
+			},
+		},
+		{
+			Description: "Throttled pt-online-schema-change",
+			Migration: config.Migration{
+				Executor: ExecutorPTO,
+				PTO: config.PTO{
+					MaxLoad:                "Threads_running=25",
+					CriticalLoad:           "Threads_running=100",
+					ChunkSize:              500,
+					RecursionMethod:        "none",
+					AlterForeignKeysMethod: "auto",
+				},
+			},
+			ExpectedName: ExecutorPTO,
+			ExpectedCmd:  "pt-online-schema-change",
+			ExpectedParams: [][]string{
+				{
+					"--defaults-file", ptoCredentials,
+					"h=127.0.0.1,P=3306,D=project,t=dogs",
+					"--alter", clause,
+					"--critical-load", "Threads_running=100",
+					"--max-load", "Threads_running=25",
+					"--chunk-size", "500",
+					"--recursion-method", "none",
+					"--alter-foreign-keys-method", "auto",
+					"--dry-run",
+				},
+				{
+					"--defaults-file", ptoCredentials,
+					"h=127.0.0.1,P=3306,D=project,t=dogs",
+					"--alter", clause,
+					"--critical-load", "Threads_running=100",
+					"--max-load", "Threads_running=25",
+					"--chunk-size", "500",
+					"--recursion-method", "none",
+					"--alter-foreign-keys-method", "auto",
+					"--execute",
+				},
+			},
+		},
+		{
+			Description: "Throttled gh-ost",
+			Migration: config.Migration{
+				Executor: ExecutorGhOst,
+				GhOst: config.GhOst{
+					MaxLoad:       "Threads_running=25",
+					CriticalLoad:  "Threads_running=100",
+					ChunkSize:     500,
+					MaxLagMillis:  1500,
+					AllowOnMaster: true,
+				},
+			},
+			ExpectedName: ExecutorGhOst,
+			ExpectedCmd:  "gh-ost",
+			ExpectedParams: [][]string{{
+				"--conf=" + ghostCredentials,
+				"--host=127.0.0.1",
+				"--port=3306",
+				"--database=project",
+				"--table=dogs",
+				"--alter=" + clause,
+				"--max-load=Threads_running=25",
+				"--critical-load=Threads_running=100",
+				"--chunk-size=500",
+				"--max-lag-millis=1500",
+				"--allow-on-master",
+				"--execute",
+			}},
+		},
+	}
+
+	for _, tst := range tests {
+		testConfig := test.GetTestConfig()
+		testConfig.Project.DB.Ip = "127.0.0.1"
+		testConfig.Project.DB.Port = 3306
+		testConfig.Project.DB.Username = "migrate"
+		testConfig.Project.DB.Password = "secret"
+		testConfig.Project.Migration = tst.Migration
+
+		// Configure unit test shell
+		util.SetConfigTesting()
+		util.Config(testConfig)
+		Setup(nil, 1, testConfig.Project)
+
+		shell := util.GetShell().(*util.MockShellExecutor)
+		for _, params := range tst.ExpectedParams {
+			shell.ExpectExec(tst.ExpectedCmd, params, "Successfully altered `project`.`dogs`", nil)
+		}
+
+		executor, err := NewExecutor(tst.Migration.Executor)
+		if err != nil {
+			t.Errorf("%s FAILED with error: %v", tst.Description, err)
+			continue
+		}
+
+		if executor.Name() != tst.ExpectedName {
+			t.Errorf("%s FAILED. Expected executor: [%s] Got: [%s]", tst.Description, tst.ExpectedName, executor.Name())
+		}
+
+		_, _, err = executor.Execute(NewTarget(statement), false)
+		if err != nil {
+			t.Errorf("%s FAILED with error: %v", tst.Description, err)
+		}
+
+		if err = shell.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s FAILED: Not all shell commands were executed: error [%v]", tst.Description, err)
+		}
+	}
+}
+
+func TestUnsupportedExecutor(t *testing.T) {
+	_, err := NewExecutor("osc")
+	if err == nil {
+		t.Errorf("Unsupported Executor FAILED. Expected an error")
+	}
+}
+
+func TestCredentialsFile(t *testing.T) {
+	testConfig := test.GetTestConfig()
+	testConfig.Project.DB.Username = "migrate"
+	testConfig.Project.DB.Password = "se\"cr#et"
+
+	util.SetConfigTesting()
+	util.Config(testConfig)
+
+	path, err := credentialsFile(ExecutorPTO, testConfig.Project.DB)
+	if err != nil {
+		t.Errorf("Credentials File FAILED with error: %v", err)
+		return
+	}
+	defer util.DeleteFile(path)
+
+	info, err := util.Stat(path)
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Credentials File FAILED. The file must only be readable by the current user: %v", err)
+	}
+
+	expected := "[client]\nuser=\"migrate\"\npassword=\"se\\\"cr#et\"\n"
+
+	data, err := util.ReadFile(path)
+	if err != nil || string(data) != expected {
+		t.Errorf("Credentials File FAILED. Expected: [%s] Got: [%s]", expected, string(data))
+	}
+}
+
+func TestPTODSN(t *testing.T) {
+	executor := PTOExecutor{
+		DB: config.DB{
+			Ip:   "db,1",
+			Port: 3306,
+		},
+	}
+
+	expected := "h=db\\,1,P=3306,D=project,t=dogs\\,cats"
+
+	result := executor.dsn(Target{Database: "project", Table: "dogs,cats"})
+	if result != expected {
+		t.Errorf("PTO DSN FAILED. Expected: [%s] Got: [%s]", expected, result)
+	}
+}
@@ -0,0 +1,77 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// GhOstExecutor Applies ALTER TABLE statements using gh-ost
+type GhOstExecutor struct {
+	DB      config.DB
+	Options config.GhOst
+}
+
+// Name Returns the name of the executor
+func (e GhOstExecutor) Name() string {
+	return ExecutorGhOst
+}
+
+// params Returns the gh-ost arguments for the target.  The credentials are read
+// from the conf file so that they aren't visible in the process list.  gh-ost only
+// performs a noop migration unless --execute is supplied.
+func (e GhOstExecutor) params(target Target, confFile string) []string {
+	params := []string{
+		fmt.Sprintf("--conf=%s", confFile),
+		fmt.Sprintf("--host=%s", e.DB.Ip),
+		fmt.Sprintf("--port=%d", e.DB.Port),
+		fmt.Sprintf("--database=%s", target.Database),
+		fmt.Sprintf("--table=%s", target.Table),
+		fmt.Sprintf("--alter=%s", target.Clause),
+	}
+
+	if len(e.Options.MaxLoad) > 0 {
+		params = append(params, fmt.Sprintf("--max-load=%s", e.Options.MaxLoad))
+	}
+	if len(e.Options.CriticalLoad) > 0 {
+		params = append(params, fmt.Sprintf("--critical-load=%s", e.Options.CriticalLoad))
+	}
+	if e.Options.ChunkSize > 0 {
+		params = append(params, fmt.Sprintf("--chunk-size=%d", e.Options.ChunkSize))
+	}
+	if e.Options.MaxLagMillis > 0 {
+		params = append(params, fmt.Sprintf("--max-lag-millis=%d", e.Options.MaxLagMillis))
+	}
+	if e.Options.AllowOnMaster {
+		params = append(params, "--allow-on-master")
+	}
+
+	return append(params, "--execute")
+}
+
+// Execute Apply the alter specification of the statement using gh-ost
+func (e GhOstExecutor) Execute(target Target, dryrun bool) (output string, rowsAffected int64, err error) {
+	var confFile string
+
+	shell := util.GetShell()
+	shell.SetPrefix("gh-ost")
+
+	if dryrun {
+		output = fmt.Sprintf("gh-ost: [gh-ost %s]", strings.Join(e.params(target, credentialsPlaceholder), " "))
+		return output, rowsAffected, err
+	}
+
+	confFile, err = credentialsFile(e.Name(), e.DB)
+	if err != nil {
+		return output, rowsAffected, err
+	}
+	defer util.DeleteFile(confFile)
+
+	params := e.params(target, confFile)
+	util.LogAlertf("gh-ost: Executing Migration: [%s]", strings.Join(params, " "))
+	output, err = shell.Run("gh-ost", params...)
+
+	return output, rowsAffected, err
+}
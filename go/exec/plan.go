@@ -0,0 +1,211 @@
+package exec
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/mysql"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// How a Plan Step changes its table
+const (
+	ChangeMetadataOnly = "metadata-only"
+	ChangeInplace      = "in-place"
+	ChangeCopy         = "copy"
+	ChangeUnknown      = "unknown"
+)
+
+// PlanStep Annotates a Migration Step with the facts a reviewer needs before approving it
+type PlanStep struct {
+	SID         int64  `json:"sid"`
+	Statement   string `json:"statement"`
+	Table       string `json:"table"`
+	Rows        int64  `json:"rows"`
+	SizeBytes   int64  `json:"size_bytes"`
+	Change      string `json:"change"`
+	Executor    string `json:"executor"`
+	Destructive bool   `json:"destructive"`
+
+	// Estimate The rough duration of the Step in seconds, based on the timings of the
+	// Steps previously applied to the table.  -1 if there is no history to estimate from.
+	Estimate int64 `json:"estimate_seconds"`
+	// History The number of previously applied Steps used for the Estimate
+	History int `json:"history"`
+}
+
+// Plan The annotated Steps of a Migration
+type Plan struct {
+	MID   int64      `json:"mid"`
+	Steps []PlanStep `json:"steps"`
+}
+
+// NewPlan Annotates each of the Steps of the Migration with the size of the table
+// it changes, how the change is applied and how long it's likely to take
+func NewPlan(mid int64, ptoDisabled bool) (plan Plan, err error) {
+	var m *migration.Migration
+	var md *metadata.Metadata
+	var executor Executor
+
+	m, err = migration.Load(mid)
+	if util.ErrorCheckf(err, "Couldn't load Migration: [%d] from the Management DB", mid) {
+		return plan, err
+	}
+
+	if _, err = ConnectProjectDB(false); util.ErrorCheck(err) {
+		return plan, err
+	}
+
+	plan.MID = m.MID
+	for _, step := range m.Steps {
+		target := NewTarget(step.Forward)
+
+		md, err = metadata.Load(step.MDID)
+		if util.ErrorCheckf(err, "The Metadata: [%d] for Step: [%d] couldn't be loaded from the Management DB", step.MDID, step.SID) {
+			return plan, err
+		}
+
+		executor, err = stepExecutor(ptoDisabled, md, step.Op, target)
+		if util.ErrorCheckf(err, "Migration Step: [%d] No executor available", step.SID) {
+			return plan, err
+		}
+
+		planStep := PlanStep{
+			SID:         step.SID,
+			Statement:   step.Forward,
+			Table:       target.Table,
+			Change:      planChange(executor, step.Forward, len(target.Clause) > 0),
+			Executor:    executor.Name(),
+			Destructive: step.IsDestructive(),
+			Estimate:    -1,
+		}
+
+		if len(target.Table) > 0 {
+			planStep.Rows, planStep.SizeBytes, err = tableSize(target.Table)
+			if err != nil {
+				return plan, err
+			}
+
+			err = planStep.estimate()
+			if err != nil {
+				return plan, err
+			}
+		}
+
+		plan.Steps = append(plan.Steps, planStep)
+	}
+
+	return plan, err
+}
+
+// planChange Returns how the statement changes its table.  External executors always
+// copy the table, otherwise the ALGORITHM requested by the statement is used.
+func planChange(executor Executor, statement string, alters bool) string {
+	if executor.Name() != ExecutorNative {
+		return ChangeCopy
+	}
+	if !alters {
+		// Creating or dropping a table doesn't copy any rows
+		return ChangeMetadataOnly
+	}
+
+	switch mysql.StatementAlgorithm(statement) {
+	case mysql.AlgorithmInstant:
+		return ChangeMetadataOnly
+	case mysql.AlgorithmInplace:
+		return ChangeInplace
+	case mysql.AlgorithmCopy:
+		return ChangeCopy
+	}
+	return ChangeUnknown
+}
+
+// tableSize Returns the row count and the data and index size of the table
+func tableSize(tableName string) (rows int64, size int64, err error) {
+	query := fmt.Sprintf("SELECT COALESCE(TABLE_ROWS, 0), COALESCE(DATA_LENGTH + INDEX_LENGTH, 0) FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = '%s'", quoteValue(tableName))
+	err = projectDB.Db.QueryRow(query).Scan(&rows, &size)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	util.ErrorCheckf(err, "Unable to read the size of Table: [%s]", tableName)
+	return rows, size, err
+}
+
+// estimate Estimates the duration of the step from the average duration of the
+// steps previously applied to its table.  Metadata only changes are instant.
+func (p *PlanStep) estimate() (err error) {
+	var durations []time.Duration
+	var total time.Duration
+
+	if p.Change == ChangeMetadataOnly {
+		p.Estimate = 0
+		return err
+	}
+
+	durations, err = migration.TableDurations(p.Table)
+	if err != nil || len(durations) == 0 {
+		return err
+	}
+
+	for _, duration := range durations {
+		total += duration
+	}
+	p.History = len(durations)
+	p.Estimate = int64((total / time.Duration(len(durations))).Seconds())
+	return err
+}
+
+// String Formats the Plan as a table for display
+func (p Plan) String() string {
+	var buffer bytes.Buffer
+
+	w := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Migration: [%d]\n", p.MID)
+	fmt.Fprintln(w, "SID\tTABLE\tROWS\tSIZE\tCHANGE\tEXECUTOR\tDESTRUCTIVE\tESTIMATE\tSTATEMENT")
+
+	for _, step := range p.Steps {
+		estimate := "unknown"
+		if step.Estimate >= 0 {
+			estimate = (time.Duration(step.Estimate) * time.Second).String()
+			if step.History > 0 {
+				estimate = fmt.Sprintf("%s (%d runs)", estimate, step.History)
+			}
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\t%s\t%t\t%s\t%s\n",
+			step.SID,
+			step.Table,
+			step.Rows,
+			formatBytes(step.SizeBytes),
+			step.Change,
+			step.Executor,
+			step.Destructive,
+			estimate,
+			strings.Replace(step.Statement, "\n", " ", -1),
+		)
+	}
+	w.Flush()
+
+	return buffer.String()
+}
+
+// formatBytes Formats a size in bytes using the largest whole unit
+func formatBytes(size int64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	value := float64(size)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, units[unit])
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
@@ -0,0 +1,172 @@
+package exec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/test"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+func TestNewPlan(t *testing.T) {
+	testName := "TestNewPlan"
+	tableSizeQuery := "FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'dogs'"
+
+	util.SetConfigTesting()
+
+	ageMd := metadata.Metadata{
+		MDID:       1,
+		DB:         1,
+		PropertyID: "age",
+		ParentID:   "dogs",
+		Name:       "age",
+		Type:       "Column",
+	}
+	nameMd := metadata.Metadata{
+		MDID:       2,
+		DB:         1,
+		PropertyID: "name",
+		ParentID:   "dogs",
+		Name:       "name",
+		Type:       "Column",
+	}
+
+	addStep := migration.Step{
+		SID:     1,
+		MID:     1,
+		Op:      table.Add,
+		MDID:    1,
+		Name:    "age",
+		Forward: "ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL, ALGORITHM=INSTANT, LOCK=NONE;",
+		Status:  migration.Approved,
+	}
+	modStep := migration.Step{
+		SID:     2,
+		MID:     1,
+		Op:      table.Mod,
+		MDID:    2,
+		Name:    "name",
+		Forward: "ALTER TABLE `dogs` MODIFY COLUMN `name` varchar(32) NOT NULL, ALGORITHM=COPY, LOCK=SHARED;",
+		Status:  migration.Approved,
+	}
+
+	// Steps previously applied to the table which took 60 and 120 seconds
+	history := []migration.Step{
+		{
+			SID:       3,
+			MID:       2,
+			Forward:   "ALTER TABLE `dogs` MODIFY COLUMN `name` varchar(64) NOT NULL;",
+			Status:    migration.Complete,
+			Started:   "2016-07-12 12:00:00",
+			Finished:  "2016-07-12 12:01:00",
+			TableName: "dogs",
+		},
+		{
+			SID:       4,
+			MID:       3,
+			Forward:   "ALTER TABLE `dogs` ADD INDEX `idx_name` (`name`);",
+			Status:    migration.Complete,
+			Started:   "2016-07-13 12:00:00",
+			Finished:  "2016-07-13 12:02:00",
+			TableName: "dogs",
+		},
+	}
+
+	m := migration.Migration{
+		MID:    1,
+		DB:     1,
+		Status: migration.Unapproved,
+	}
+
+	projectDB, err := test.CreateProjectDB(testName, t)
+	if err != nil {
+		t.Errorf("%s failed to setup the Project DB with error: %v", testName, err)
+		return
+	}
+	SetProjectDB(projectDB.Db)
+
+	mgmtDB, err := test.CreateManagementDB(testName, t)
+	if err != nil {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+	Setup(mgmtDB.Db, 1, config.Project{})
+	migration.Setup(mgmtDB.Db, 1)
+	metadata.Setup(mgmtDB.Db, 1)
+
+	mgmtDB.MigrationGet(1, m.ToDBRow(), false)
+	mgmtDB.MigrationStepsGet(1, []test.DBRow{addStep.ToDBRow(), modStep.ToDBRow()}, false)
+
+	// The column added instantly isn't estimated from the history
+	mgmtDB.MetadataGet(1, ageMd.ToDBRow(), false)
+	projectDB.ExpectQuery(test.DBQueryMock{
+		Query:   tableSizeQuery,
+		Columns: []string{"TABLE_ROWS", "SIZE"},
+		Rows:    []test.DBRow{{5000, 3 * 1024 * 1024}},
+	})
+
+	// The copied column is estimated from the history of the table
+	mgmtDB.MetadataGet(2, nameMd.ToDBRow(), false)
+	projectDB.ExpectQuery(test.DBQueryMock{
+		Query:   tableSizeQuery,
+		Columns: []string{"TABLE_ROWS", "SIZE"},
+		Rows:    []test.DBRow{{5000, 3 * 1024 * 1024}},
+	})
+	mgmtDB.MigrationStepsGetTable(1, "dogs", []test.DBRow{history[0].ToDBRow(), history[1].ToDBRow()})
+
+	plan, err := NewPlan(1, true)
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+		return
+	}
+
+	expected := []PlanStep{
+		{
+			SID:         1,
+			Statement:   addStep.Forward,
+			Table:       "dogs",
+			Rows:        5000,
+			SizeBytes:   3 * 1024 * 1024,
+			Change:      ChangeMetadataOnly,
+			Executor:    ExecutorNative,
+			Destructive: false,
+			Estimate:    0,
+		},
+		{
+			SID:         2,
+			Statement:   modStep.Forward,
+			Table:       "dogs",
+			Rows:        5000,
+			SizeBytes:   3 * 1024 * 1024,
+			Change:      ChangeCopy,
+			Executor:    ExecutorNative,
+			Destructive: true,
+			Estimate:    90,
+			History:     2,
+		},
+	}
+
+	if len(plan.Steps) != len(expected) {
+		t.Errorf("%s FAILED. Expected: %d Steps Got: %d", testName, len(expected), len(plan.Steps))
+	} else {
+		for i, step := range plan.Steps {
+			if step != expected[i] {
+				t.Errorf("%s FAILED. Step: %d Expected: %v Got: %v", testName, i, expected[i], step)
+			}
+		}
+	}
+
+	output := plan.String()
+	for _, expectedOutput := range []string{"metadata-only", "1m30s (2 runs)", "3.0 MB"} {
+		if !strings.Contains(output, expectedOutput) {
+			t.Errorf("%s FAILED. Plan output is missing: [%s]\n%s", testName, expectedOutput, output)
+		}
+	}
+
+	projectDB.ExpectionsMet(testName, t)
+	mgmtDB.ExpectionsMet(testName, t)
+}
@@ -0,0 +1,275 @@
+package exec
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/mysql"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// Finding The result of a pre-flight check which either warns about or refuses a step
+type Finding struct {
+	Refuse  bool
+	Message string
+}
+
+// String Formats the Finding for the step output
+func (f Finding) String() string {
+	if f.Refuse {
+		return fmt.Sprintf("Pre-flight: REFUSED: %s", f.Message)
+	}
+	return fmt.Sprintf("Pre-flight: WARNING: %s", f.Message)
+}
+
+// Findings The results of the pre-flight checks of a step
+type Findings []Finding
+
+// Refused Returns true if any of the checks refused the step
+func (f Findings) Refused() bool {
+	for _, finding := range f {
+		if finding.Refuse {
+			return true
+		}
+	}
+	return false
+}
+
+// String Formats the Findings for the step output
+func (f Findings) String() string {
+	var lines []string
+	for _, finding := range f {
+		lines = append(lines, finding.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// check Records a Finding if the value exceeds either of the thresholds
+func (f *Findings) check(value int64, warn int64, max int64, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if max > 0 && value > max {
+		*f = append(*f, Finding{Refuse: true, Message: fmt.Sprintf("%s exceeds the maximum of %d", message, max)})
+	} else if warn > 0 && value > warn {
+		*f = append(*f, Finding{Message: fmt.Sprintf("%s exceeds the warning threshold of %d", message, warn)})
+	}
+}
+
+// connectReplica Opens a connection to a replica of the project database.
+// Unit Tests replace this to control replica access.
+var connectReplica = func(db config.DB) (*sql.DB, error) {
+	return sql.Open("mysql", db.ConnectString())
+}
+
+// preflightEnabled Returns true if any of the pre-flight checks are configured
+func preflightEnabled(pf config.Preflight) bool {
+	return pf.WarnRows > 0 || pf.MaxRows > 0 || pf.WarnSizeMB > 0 || pf.MaxSizeMB > 0 ||
+		pf.MaxTransactionSeconds > 0 || pf.MaxReplicaLagSeconds > 0 || pf.FreeSpaceRatio > 0
+}
+
+// preflightStep Checks the project database for signals that applying the statement of a
+// step is risky.  copiesTable is true if the executor rebuilds the table into a copy.
+func preflightStep(target Target, copiesTable bool) (findings Findings, err error) {
+	var rows int64
+	var size int64
+
+	pf := projectConfig.Migration.Preflight
+
+	if !preflightEnabled(pf) {
+		return findings, err
+	}
+
+	if _, err = ConnectProjectDB(false); util.ErrorCheck(err) {
+		return findings, err
+	}
+
+	// The size of the altered table
+	if len(target.Table) > 0 && (pf.WarnRows > 0 || pf.MaxRows > 0 || pf.WarnSizeMB > 0 || pf.MaxSizeMB > 0 || pf.FreeSpaceRatio > 0) {
+		rows, size, err = tableSize(target.Table)
+		if err != nil {
+			return findings, err
+		}
+
+		findings.check(rows, pf.WarnRows, pf.MaxRows, "Table: [%s] has %d rows which", target.Table, rows)
+		sizeMB := size / (1024 * 1024)
+		findings.check(sizeMB, pf.WarnSizeMB, pf.MaxSizeMB, "Table: [%s] is %d MB which", target.Table, sizeMB)
+	}
+
+	// Long running transactions will block the metadata lock required by the ALTER
+	if pf.MaxTransactionSeconds > 0 {
+		var count int64
+		var oldest int64
+		query := fmt.Sprintf("SELECT COUNT(*), COALESCE(MAX(TIMESTAMPDIFF(SECOND, trx_started, NOW())), 0) FROM information_schema.INNODB_TRX WHERE TIMESTAMPDIFF(SECOND, trx_started, NOW()) > %d", pf.MaxTransactionSeconds)
+		err = projectDB.Db.QueryRow(query).Scan(&count, &oldest)
+		if util.ErrorCheck(err) {
+			return findings, err
+		}
+		if count > 0 {
+			findings = append(findings, Finding{
+				Refuse:  true,
+				Message: fmt.Sprintf("%d transaction(s) have been running for longer than %d seconds.  The oldest has been running for %d seconds", count, pf.MaxTransactionSeconds, oldest),
+			})
+		}
+	}
+
+	// Replica lag
+	if pf.MaxReplicaLagSeconds > 0 {
+		for _, replica := range pf.Replicas {
+			var finding Finding
+			finding, err = checkReplicaLag(replica, pf.MaxReplicaLagSeconds)
+			if err != nil {
+				return findings, err
+			}
+			if len(finding.Message) > 0 {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	// Copying the table requires free space for the copy.  The free space is read from the
+	// local file system, so it can only be checked when migrate runs on the database host.
+	if copiesTable && pf.FreeSpaceRatio > 0 && len(pf.DataDir) > 0 && size > 0 {
+		var available int64
+		var local bool
+		var dbHost string
+
+		local, dbHost, err = onDatabaseHost()
+		if err != nil {
+			return findings, err
+		}
+		if !local {
+			findings = append(findings, Finding{
+				Message: fmt.Sprintf("Free space of: [%s] wasn't checked because migrate isn't running on the database host: [%s]", pf.DataDir, dbHost),
+			})
+		} else {
+			available, err = freeSpace(pf.DataDir)
+			if err != nil {
+				return findings, err
+			}
+		}
+		required := int64(float64(size) * pf.FreeSpaceRatio)
+		if local && available < required {
+			findings = append(findings, Finding{
+				Refuse:  true,
+				Message: fmt.Sprintf("%d MB free in: [%s] is less than the %d MB required to copy Table: [%s]", available/(1024*1024), pf.DataDir, required/(1024*1024), target.Table),
+			})
+		}
+	}
+
+	for _, finding := range findings {
+		if finding.Refuse {
+			util.LogError(finding.String())
+		} else {
+			util.LogWarn(finding.String())
+		}
+	}
+
+	return findings, err
+}
+
+// checkReplicaLag Returns a Finding if the replica is lagging further behind than the
+// maximum, or if it isn't replicating
+func checkReplicaLag(replica config.DB, maxLag int64) (finding Finding, err error) {
+	var db *sql.DB
+	var rows *sql.Rows
+	var columns []string
+
+	host := fmt.Sprintf("%s:%d", replica.Ip, replica.Port)
+
+	db, err = connectReplica(replica)
+	if util.ErrorCheckf(err, "Pre-flight: Unable to connect to Replica: [%s]", host) {
+		return finding, err
+	}
+	defer db.Close()
+
+	rows, err = db.Query("SHOW SLAVE STATUS")
+	if util.ErrorCheckf(err, "Pre-flight: Unable to read the status of Replica: [%s]", host) {
+		return finding, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return Finding{Refuse: true, Message: fmt.Sprintf("Replica: [%s] is not replicating", host)}, rows.Err()
+	}
+
+	columns, err = rows.Columns()
+	if err != nil {
+		return finding, err
+	}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	err = rows.Scan(dest...)
+	if err != nil {
+		return finding, err
+	}
+
+	for i, column := range columns {
+		if column != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return Finding{Refuse: true, Message: fmt.Sprintf("Replica: [%s] is not replicating", host)}, err
+		}
+		lag, _ := strconv.ParseInt(values[i].String, 10, 64)
+		if lag > maxLag {
+			finding = Finding{Refuse: true, Message: fmt.Sprintf("Replica: [%s] is %d seconds behind which exceeds the maximum of %d", host, lag, maxLag)}
+		}
+	}
+
+	return finding, err
+}
+
+// onDatabaseHost Returns true if migrate is running on the host of the project database,
+// comparing the hostname reported by MySQL with the local hostname
+func onDatabaseHost() (local bool, dbHost string, err error) {
+	err = projectDB.Db.QueryRow("SELECT @@hostname").Scan(&dbHost)
+	if util.ErrorCheckf(err, "Pre-flight: Unable to read the hostname of the project database") {
+		return local, dbHost, err
+	}
+
+	host, err := os.Hostname()
+	if util.ErrorCheckf(err, "Pre-flight: Unable to read the local hostname") {
+		return local, dbHost, err
+	}
+
+	// Either hostname may or may not be fully qualified
+	shortName := func(name string) string {
+		return strings.ToLower(strings.Split(strings.TrimSpace(name), ".")[0])
+	}
+	return shortName(dbHost) == shortName(host), dbHost, err
+}
+
+// freeSpace Returns the number of bytes available in the file system of the path
+func freeSpace(path string) (available int64, err error) {
+	var output string
+
+	shell := util.GetShell()
+	shell.SetPrefix("df")
+
+	output, err = shell.Run("df", "-Pk", path)
+	if util.ErrorCheckf(err, "Pre-flight: Unable to read the free space of: [%s]", path) {
+		return available, err
+	}
+
+	// Filesystem 1024-blocks Used Available Capacity Mounted on
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 4 {
+		return available, fmt.Errorf("Pre-flight: Unable to parse the free space of: [%s] from: [%s]", path, output)
+	}
+
+	available, err = strconv.ParseInt(fields[3], 10, 64)
+	return available * 1024, err
+}
+
+// copiesTable Returns true if the executor rebuilds the table into a copy to apply the statement
+func copiesTable(executor Executor, statement string) bool {
+	return executor.Name() != ExecutorNative || mysql.StatementAlgorithm(statement) == mysql.AlgorithmCopy
+}
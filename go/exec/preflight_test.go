@@ -0,0 +1,167 @@
+package exec
+
+import (
+	"database/sql"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/test"
+	"github.com/freneticmonkey/migrate/go/util"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestPreflightStep(t *testing.T) {
+	target := Target{
+		Database: "project",
+		Table:    "dogs",
+		Clause:   "ADD COLUMN `name` varchar(64) NOT NULL",
+	}
+	tableSize := "SELECT COALESCE(TABLE_ROWS, 0), COALESCE(DATA_LENGTH + INDEX_LENGTH, 0) FROM information_schema.TABLES"
+	transactions := "FROM information_schema.INNODB_TRX"
+
+	var tests = []struct {
+		Description      string
+		Preflight        config.Preflight
+		Rows             int64
+		Size             int64
+		Transactions     int64
+		ReplicaLag       string
+		DBHost           string
+		Available        string
+		ExpectedRefused  bool
+		ExpectedFindings []string
+	}{
+		{
+			Description: "Disabled",
+		},
+		{
+			Description: "Warn about a large table",
+			Preflight: config.Preflight{
+				WarnRows:  1000,
+				MaxRows:   1000000,
+				MaxSizeMB: 1024,
+			},
+			Rows: 5000,
+			Size: 10 * 1024 * 1024,
+			ExpectedFindings: []string{
+				"Pre-flight: WARNING: Table: [dogs] has 5000 rows which exceeds the warning threshold of 1000",
+			},
+		},
+		{
+			Description: "Refuse long transactions, replica lag and insufficient disk space",
+			Preflight: config.Preflight{
+				MaxSizeMB:             1024,
+				MaxTransactionSeconds: 30,
+				Replicas: []config.DB{
+					{Ip: "10.0.0.2", Port: 3306},
+				},
+				MaxReplicaLagSeconds: 60,
+				DataDir:              "/var/lib/mysql",
+				FreeSpaceRatio:       2,
+			},
+			Rows:            1000,
+			Size:            200 * 1024 * 1024,
+			Transactions:    2,
+			ReplicaLag:      "120",
+			Available:       "Filesystem 1024-blocks Used Available Capacity Mounted on\n/dev/sda1 1048576 819200 204800 80% /var/lib/mysql",
+			ExpectedRefused: true,
+			ExpectedFindings: []string{
+				"Pre-flight: REFUSED: 2 transaction(s) have been running for longer than 30 seconds",
+				"Pre-flight: REFUSED: Replica: [10.0.0.2:3306] is 120 seconds behind which exceeds the maximum of 60",
+				"Pre-flight: REFUSED: 200 MB free in: [/var/lib/mysql] is less than the 400 MB required to copy Table: [dogs]",
+			},
+		},
+		{
+			Description: "Skip the disk space check when not running on the database host",
+			Preflight: config.Preflight{
+				DataDir:        "/var/lib/mysql",
+				FreeSpaceRatio: 2,
+			},
+			Rows:   1000,
+			Size:   200 * 1024 * 1024,
+			DBHost: "db-primary-1.example.com",
+			ExpectedFindings: []string{
+				"Pre-flight: WARNING: Free space of: [/var/lib/mysql] wasn't checked because migrate isn't running on the database host: [db-primary-1.example.com]",
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		testConfig := test.GetTestConfig()
+		testConfig.Project.Migration.Preflight = tst.Preflight
+
+		util.SetConfigTesting()
+		util.Config(testConfig)
+		Setup(nil, 1, testConfig.Project)
+
+		projectDB, err := test.CreateProjectDB(tst.Description, t)
+		if err != nil {
+			return
+		}
+		SetProjectDB(projectDB.Db)
+
+		if preflightEnabled(tst.Preflight) {
+			projectDB.Mock.ExpectQuery(regexp.QuoteMeta(tableSize)).
+				WillReturnRows(sqlmock.NewRows([]string{"rows", "size"}).AddRow(tst.Rows, tst.Size))
+		}
+
+		if tst.Preflight.MaxTransactionSeconds > 0 {
+			projectDB.Mock.ExpectQuery(regexp.QuoteMeta(transactions)).
+				WillReturnRows(sqlmock.NewRows([]string{"count", "oldest"}).AddRow(tst.Transactions, 95))
+		}
+
+		if len(tst.ReplicaLag) > 0 {
+			replicaDB, replicaMock, _ := sqlmock.New()
+			replicaMock.ExpectQuery("SHOW SLAVE STATUS").
+				WillReturnRows(sqlmock.NewRows([]string{"Slave_IO_State", "Seconds_Behind_Master"}).AddRow("Waiting for master to send event", tst.ReplicaLag))
+			connectReplica = func(db config.DB) (*sql.DB, error) {
+				return replicaDB, nil
+			}
+		}
+
+		if tst.Preflight.FreeSpaceRatio > 0 {
+			dbHost := tst.DBHost
+			if len(dbHost) == 0 {
+				dbHost, _ = os.Hostname()
+			}
+			projectDB.Mock.ExpectQuery(regexp.QuoteMeta("SELECT @@hostname")).
+				WillReturnRows(sqlmock.NewRows([]string{"hostname"}).AddRow(dbHost))
+		}
+
+		shell := util.GetShell().(*util.MockShellExecutor)
+		if len(tst.Available) > 0 {
+			shell.ExpectExec("df", []string{"-Pk", tst.Preflight.DataDir}, tst.Available, nil)
+		}
+
+		findings, err := preflightStep(target, true)
+
+		if err != nil {
+			t.Errorf("%s FAILED with error: %v", tst.Description, err)
+			continue
+		}
+
+		if findings.Refused() != tst.ExpectedRefused {
+			t.Errorf("%s FAILED. Expected refused: %t Got: %t", tst.Description, tst.ExpectedRefused, findings.Refused())
+		}
+
+		if len(findings) != len(tst.ExpectedFindings) {
+			t.Errorf("%s FAILED. Expected %d findings Got: [%s]", tst.Description, len(tst.ExpectedFindings), findings)
+			continue
+		}
+
+		for i, expected := range tst.ExpectedFindings {
+			if !strings.HasPrefix(findings[i].String(), expected) {
+				t.Errorf("%s FAILED. Expected finding: [%s] Got: [%s]", tst.Description, expected, findings[i])
+			}
+		}
+
+		if err = shell.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s FAILED: Not all shell commands were executed: error [%v]", tst.Description, err)
+		}
+
+		projectDB.ExpectionsMet(tst.Description, t)
+	}
+}
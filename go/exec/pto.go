@@ -4,28 +4,113 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/freneticmonkey/migrate/go/config"
 	"github.com/freneticmonkey/migrate/go/util"
 )
 
-func executePTO(statement string, dryrun bool) (output string, err error) {
+// PTOExecutor Applies ALTER TABLE statements using pt-online-schema-change
+type PTOExecutor struct {
+	DB      config.DB
+	Options config.PTO
+}
 
-	shell := util.GetShell()
-	shell.SetPrefix("pto")
+// Name Returns the name of the executor
+func (e PTOExecutor) Name() string {
+	return ExecutorPTO
+}
+
+// dsnValue Escapes the commas of a value which would otherwise separate the parts
+// of a pt-online-schema-change DSN
+func dsnValue(value string) string {
+	return strings.Replace(value, ",", "\\,", -1)
+}
+
+// dsn Returns the pt-online-schema-change DSN of the target table.  The credentials
+// are read from the defaults file so that they aren't visible in the process list.
+func (e PTOExecutor) dsn(target Target) string {
+	var parts []string
+
+	if len(e.DB.Ip) > 0 {
+		parts = append(parts, fmt.Sprintf("h=%s", dsnValue(e.DB.Ip)))
+	}
+	if e.DB.Port > 0 {
+		parts = append(parts, fmt.Sprintf("P=%d", e.DB.Port))
+	}
+
+	parts = append(parts,
+		fmt.Sprintf("D=%s", dsnValue(target.Database)),
+		fmt.Sprintf("t=%s", dsnValue(target.Table)),
+	)
+
+	return strings.Join(parts, ",")
+}
+
+// params Returns the pt-online-schema-change arguments for the target.  defaultsFile
+// is the path of the file containing the credentials and mode is either --dry-run
+// or --execute.
+func (e PTOExecutor) params(target Target, defaultsFile string, mode string) []string {
+	criticalLoad := e.Options.CriticalLoad
+	if len(criticalLoad) == 0 {
+		criticalLoad = "Threads_running=500"
+	}
 
 	params := []string{
-		fmt.Sprintf("D=%s", "test"),
-		fmt.Sprintf("t=%s", "test"),
-		fmt.Sprintf("--alter \"%s\"", statement),
-		"--critical-load \"Threads_running=500\"",
-		"--execute",
+		"--defaults-file", defaultsFile,
+		e.dsn(target),
+		"--alter", target.Clause,
+		"--critical-load", criticalLoad,
+	}
+
+	if len(e.Options.MaxLoad) > 0 {
+		params = append(params, "--max-load", e.Options.MaxLoad)
+	}
+	if e.Options.ChunkSize > 0 {
+		params = append(params, "--chunk-size", fmt.Sprintf("%d", e.Options.ChunkSize))
+	}
+	if len(e.Options.RecursionMethod) > 0 {
+		params = append(params, "--recursion-method", e.Options.RecursionMethod)
+	}
+	if len(e.Options.AlterForeignKeysMethod) > 0 {
+		params = append(params, "--alter-foreign-keys-method", e.Options.AlterForeignKeysMethod)
 	}
 
+	return append(params, mode)
+}
+
+// Execute Apply the alter specification of the statement using pt-online-schema-change.
+// A --dry-run pass creates and alters the new table without copying any rows, so
+// that invalid alter specifications fail before the table is copied.
+func (e PTOExecutor) Execute(target Target, dryrun bool) (output string, rowsAffected int64, err error) {
+	var dryrunOutput string
+	var defaultsFile string
+
+	shell := util.GetShell()
+	shell.SetPrefix("pto")
+
 	if dryrun {
-		output = fmt.Sprintf("PTO: [pt-online-schema-change %s]", strings.Join(params, " "))
-	} else {
-		util.LogAlertf("PTO: Executing Migration: [%s]", strings.Join(params, " "))
-		output, err = shell.Run("pt-online-schema-change", params...)
+		output = fmt.Sprintf("PTO: [pt-online-schema-change %s]", strings.Join(e.params(target, credentialsPlaceholder, "--execute"), " "))
+		return output, rowsAffected, err
+	}
+
+	defaultsFile, err = credentialsFile(e.Name(), e.DB)
+	if err != nil {
+		return output, rowsAffected, err
 	}
+	defer util.DeleteFile(defaultsFile)
+
+	dryrunParams := e.params(target, defaultsFile, "--dry-run")
+	util.LogAlertf("PTO: Verifying Migration: [%s]", strings.Join(dryrunParams, " "))
+	dryrunOutput, err = shell.Run("pt-online-schema-change", dryrunParams...)
+	if err != nil {
+		return dryrunOutput, rowsAffected, err
+	}
+
+	params := e.params(target, defaultsFile, "--execute")
+	util.LogAlertf("PTO: Executing Migration: [%s]", strings.Join(params, " "))
+	output, err = shell.Run("pt-online-schema-change", params...)
+
+	// Record the output of both passes
+	output = fmt.Sprintf("%s\n%s", dryrunOutput, output)
 
-	return output, err
+	return output, rowsAffected, err
 }
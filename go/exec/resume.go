@@ -0,0 +1,202 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/lock"
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/mysql"
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// isApplied Returns true if the step has already been applied to the project database
+func isApplied(step migration.Step) bool {
+	return step.Status == migration.Complete || step.Status == migration.ForcedCI
+}
+
+// quoteValue Escapes a schema object name for use in an information_schema query
+func quoteValue(value string) string {
+	return strings.Replace(value, "'", "''", -1)
+}
+
+// schemaObjectExists Checks the live schema of the project database for the object
+// described by the Metadata.  checked is false if the type of object can't be verified.
+func schemaObjectExists(md *metadata.Metadata, tableName string, name string) (exists bool, checked bool, err error) {
+	var query string
+	var count int64
+
+	switch md.Type {
+	case "Table":
+		query = fmt.Sprintf("SELECT COUNT(*) FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = '%s'", quoteValue(name))
+	case "Column":
+		query = fmt.Sprintf("SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = '%s' AND COLUMN_NAME = '%s'", quoteValue(tableName), quoteValue(name))
+	case "PrimaryKey", "Index":
+		if md.Type == "PrimaryKey" {
+			name = "PRIMARY"
+		}
+		query = fmt.Sprintf("SELECT COUNT(*) FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = '%s' AND INDEX_NAME = '%s'", quoteValue(tableName), quoteValue(name))
+	default:
+		return exists, false, err
+	}
+
+	if _, err = ConnectProjectDB(false); err != nil {
+		return exists, false, err
+	}
+
+	count, err = projectDB.SelectInt(query)
+	if util.ErrorCheckf(err, "Unable to read the live schema of the Project DB") {
+		return exists, false, err
+	}
+
+	return count > 0, true, err
+}
+
+// verifyStep Verifies that the live schema of the project database contains the
+// changes of a step which has already been applied.  Objects which can't be read
+// from information_schema are assumed to have been applied.
+func verifyStep(step migration.Step) (verified bool, reason string, err error) {
+	var ops []migration.StepOperation
+	var md *metadata.Metadata
+	var exists bool
+	var checked bool
+
+	ops, err = step.GetOperations()
+	if err != nil {
+		return verified, reason, err
+	}
+
+	tableName, _, _ := mysql.AlterTableClause(step.Forward)
+
+	for _, op := range ops {
+		md, err = metadata.Load(op.MDID)
+		if util.ErrorCheckf(err, "The Metadata: [%d] for Step: [%d] couldn't be loaded from the Management DB", op.MDID, step.SID) {
+			return verified, reason, err
+		}
+
+		exists, checked, err = schemaObjectExists(md, tableName, op.Name)
+		if err != nil {
+			return verified, reason, err
+		}
+
+		if checked && exists != (op.Op != table.Del) {
+			if exists {
+				reason = fmt.Sprintf("%s: [%s] still exists in the Project DB", md.Type, op.Name)
+			} else {
+				reason = fmt.Sprintf("%s: [%s] doesn't exist in the Project DB", md.Type, op.Name)
+			}
+			return false, reason, err
+		}
+	}
+
+	return true, reason, err
+}
+
+// likePattern Escapes the LIKE wildcards of a value so that it's matched literally
+func likePattern(value string) string {
+	value = strings.Replace(value, "\\", "\\\\", -1)
+	value = strings.Replace(value, "%", "\\%", -1)
+	return strings.Replace(value, "_", "\\_", -1)
+}
+
+// stepStillRunning Checks the process list of the project database for a query which
+// is still applying the step.  This is either the statement of the step itself, or
+// a query on the shadow table used by pt-online-schema-change or gh-ost to alter
+// the table online.
+func stepStillRunning(step migration.Step) (running bool, err error) {
+	var count int64
+
+	statement := strings.TrimSuffix(strings.TrimSpace(step.Forward), ";")
+	patterns := []interface{}{likePattern(statement) + "%"}
+
+	if tableName, _, ok := mysql.AlterTableClause(step.Forward); ok {
+		for _, shadow := range []string{"_%s_new", "_%s_gho"} {
+			patterns = append(patterns, "%`"+likePattern(fmt.Sprintf(shadow, tableName))+"`%")
+		}
+	}
+
+	conditions := strings.TrimSuffix(strings.Repeat("INFO LIKE ? OR ", len(patterns)), " OR ")
+	query := fmt.Sprintf("SELECT COUNT(*) FROM information_schema.PROCESSLIST WHERE ID != CONNECTION_ID() AND (%s)", conditions)
+
+	count, err = projectDB.SelectInt(query, patterns...)
+	if util.ErrorCheckf(err, "Unable to read the process list of the Project DB") {
+		return running, err
+	}
+
+	return count > 0, err
+}
+
+// haltMigration Records the reason the Migration halted before applying the step into
+// the output of the step.  The Migration is marked as Failed so that it can be resumed
+// once the cause has been resolved.
+func haltMigration(m *migration.Migration, halted int, reason error) (err error) {
+	m.Steps[halted].Output = fmt.Sprintf("Halted: %v", reason)
+	m.Status = migration.Failed
+
+	err = m.Update()
+	util.ErrorCheckf(err, "Unable to record why Migration: [%d] halted", m.MID)
+
+	return err
+}
+
+// Recover Clears the InProgress state left behind by a Migration whose process was
+// killed.  The InProgress steps of the Migration are marked as Failed so that it can
+// be resumed.  The Migration Lock is acquired first, so recovery fails while another
+// process is still applying a Migration to the target database, or if the project
+// database is still running a query applying any of the InProgress steps.
+func Recover(mid int64) (err error) {
+	var m *migration.Migration
+	var lease *lock.Lease
+	var running bool
+
+	lease, err = lock.Acquire(mid)
+	if util.ErrorCheckf(err, "Migration: [%d] cannot be recovered while the Migration Lock is held", mid) {
+		return err
+	}
+	defer lease.Release()
+
+	m, err = migration.Load(mid)
+	if util.ErrorCheckf(err, "Couldn't load Migration: [%d] from the Management DB", mid) {
+		return err
+	}
+
+	if m.Status != migration.InProgress {
+		return fmt.Errorf("Migration: [%d] cannot be recovered because it isn't InProgress.  Status: [%s]", mid, migration.StatusString[m.Status])
+	}
+
+	if _, err = ConnectProjectDB(false); util.ErrorCheck(err) {
+		return err
+	}
+
+	for _, step := range m.Steps {
+		if step.Status != migration.InProgress {
+			continue
+		}
+
+		running, err = stepStillRunning(step)
+		if err != nil {
+			return err
+		}
+
+		if running {
+			return fmt.Errorf("Migration: [%d] cannot be recovered because Step: [%d] is still being applied", mid, step.SID)
+		}
+	}
+
+	for i := range m.Steps {
+		if m.Steps[i].Status == migration.InProgress {
+			m.Steps[i].Status = migration.Failed
+			m.Steps[i].Output = "Interrupted: Recovered from a stale InProgress state"
+		}
+	}
+
+	m.Status = migration.Failed
+	err = m.Update()
+	if !util.ErrorCheckf(err, "Unable to update Migration: [%d]", mid) {
+		util.LogInfof("Migration: [%d] recovered.  Use --resume to continue applying it.", mid)
+	}
+
+	return err
+}
@@ -0,0 +1,112 @@
+package exec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/test"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestHaltMigration(t *testing.T) {
+	testName := "TestHaltMigration"
+
+	applied := migration.Step{
+		SID:       1,
+		MID:       1,
+		Op:        table.Add,
+		MDID:      1,
+		Name:      "age",
+		Forward:   "ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+		Backward:  "ALTER TABLE `dogs` DROP COLUMN `age`;",
+		Output:    "Row(s) Affected: 1",
+		Status:    migration.Complete,
+		TableName: "dogs",
+	}
+
+	halted := migration.Step{
+		SID:       2,
+		MID:       1,
+		Op:        table.Add,
+		MDID:      2,
+		Name:      "name",
+		Forward:   "ALTER TABLE `dogs` ADD COLUMN `name` varchar(64) NOT NULL;",
+		Backward:  "ALTER TABLE `dogs` DROP COLUMN `name`;",
+		Status:    migration.Approved,
+		TableName: "dogs",
+	}
+
+	m := migration.Migration{
+		MID:              1,
+		DB:               1,
+		Project:          "UnitTestProject",
+		Version:          "abc123",
+		VersionTimestamp: "2016-07-12 12:04:05",
+		Status:           migration.InProgress,
+		Steps:            []migration.Step{applied, halted},
+	}
+
+	reason := fmt.Errorf("Migration: [1] halted before Step: [2] because the Migration Lock was lost")
+
+	mgmtDB, err := test.CreateManagementDB(testName, t)
+	if err != nil {
+		t.Errorf("%s failed to setup the Management DB with error: %v", testName, err)
+		return
+	}
+	migration.Setup(mgmtDB.Db, 1)
+
+	expectStepUpdate := func(step migration.Step, output string) {
+		mgmtDB.Mock.ExpectExec("update `migration_steps`").WithArgs(
+			step.MID,
+			step.Op,
+			step.MDID,
+			step.Name,
+			step.Forward,
+			step.Backward,
+			output,
+			step.Status,
+			"",
+			step.Safe,
+			step.Operations,
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			step.TableName,
+			step.SID,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+
+	// The Migration is marked as Failed so that it can be resumed
+	mgmtDB.Mock.ExpectExec("update `migration`").WithArgs(
+		m.DB,
+		m.Project,
+		m.Version,
+		m.VersionTimestamp,
+		m.VersionDescription,
+		migration.Failed,
+		"",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		m.MID,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Only the Step which wasn't applied records why the Migration halted
+	expectStepUpdate(applied, applied.Output)
+	expectStepUpdate(halted, fmt.Sprintf("Halted: %v", reason))
+
+	err = haltMigration(&m, 1, reason)
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+	}
+	if m.Status != migration.Failed {
+		t.Errorf("%s FAILED. Expected the Migration to be Failed. Status: [%s]", testName, migration.StatusString[m.Status])
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
@@ -0,0 +1,67 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/migration"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// rollbackApplied Undo a failed Migration by applying the Backward statements of the
+// steps applied before the failed step in reverse order.  Each undone step is marked as
+// Rollback and its Metadata is reverted.  The rollback halts at the first Backward
+// statement which fails, as the steps before it can't be safely undone.
+func rollbackApplied(m *migration.Migration, failed int, ptoDisabled bool) (err error) {
+	var md *metadata.Metadata
+	var executor Executor
+	var output string
+
+	util.LogWarnf("Rolling back the Steps applied by Migration: [%d]", m.MID)
+
+	for i := failed - 1; i >= 0; i-- {
+		step := m.Steps[i]
+
+		if !isApplied(step) {
+			continue
+		}
+
+		md, err = metadata.Load(step.MDID)
+		if util.ErrorCheckf(err, "The Metadata: [%d] for Step: [%d] couldn't be loaded from the Management DB", step.MDID, step.SID) {
+			return err
+		}
+
+		target := NewTarget(step.Backward)
+		executor, err = stepExecutor(ptoDisabled, md, step.Op, target)
+		if util.ErrorCheckf(err, "Migration Step: [%d] No executor available", step.SID) {
+			return err
+		}
+
+		util.LogAlertf("Rollback Step: [%d] Executor: [%s]", step.SID, executor.Name())
+		output, _, err = executor.Execute(target, false)
+
+		if util.ErrorCheckf(err, "Rollback of Migration Step: [%d] Failed", step.SID) {
+			m.Steps[i].Output = fmt.Sprintf("%s\nRollback Failed with Error: %v", step.Output, err)
+			if updateErr := m.Steps[i].Update(); updateErr != nil {
+				return updateErr
+			}
+			return fmt.Errorf("Rollback of Step: [%d] failed with Error: %v", step.SID, err)
+		}
+
+		m.Steps[i].Output = fmt.Sprintf("%s\nRollback: %s", step.Output, output)
+		m.Steps[i].Status = migration.Rollback
+
+		err = m.Steps[i].Update()
+		if err != nil {
+			return err
+		}
+
+		// Revert the Metadata changed by the step
+		err = m.Steps[i].UpdateMetadata()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
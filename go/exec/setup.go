@@ -3,6 +3,7 @@ package exec
 import (
 	"database/sql"
 
+	"github.com/freneticmonkey/migrate/go/config"
 	"github.com/freneticmonkey/migrate/go/util"
 	"github.com/go-gorp/gorp"
 )
@@ -11,6 +12,7 @@ var mgmtDb *gorp.DbMap
 var projectDB *gorp.DbMap
 var projectConnectionDetails string
 var projectDBID int
+var projectConfig config.Project
 
 // SetProjectDB Used to set a configured gorp.DbMap so that Unit Tests
 // can control project database access
@@ -19,10 +21,11 @@ func SetProjectDB(pdb *gorp.DbMap) {
 }
 
 // Setup Setup the migration tables in the management DB
-func Setup(db *gorp.DbMap, projectDatabaseID int, projectConnDetails string) {
+func Setup(db *gorp.DbMap, projectDatabaseID int, project config.Project) {
 	mgmtDb = db
 	projectDBID = projectDatabaseID
-	projectConnectionDetails = projectConnDetails
+	projectConfig = project
+	projectConnectionDetails = project.DB.ConnectString()
 }
 
 // ConnectProjectDB Setup the Database connection to the project database.
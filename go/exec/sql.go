@@ -9,9 +9,14 @@ import (
 
 // ExecuteSQL Execute SQL in the Project DB
 func ExecuteSQL(statement string, dryrun bool) (output string, err error) {
+	output, _, err = executeSQL(statement, dryrun)
+	return output, err
+}
+
+// executeSQL Execute SQL in the Project DB and return the number of rows it affected
+func executeSQL(statement string, dryrun bool) (output string, rowsAffected int64, err error) {
 	var ready bool
 	var result sql.Result
-	var rowsAffected int64
 
 	// Ensure that the project DB connection is open
 	ready, err = ConnectProjectDB(false)
@@ -25,18 +30,24 @@ func ExecuteSQL(statement string, dryrun bool) (output string, err error) {
 
 			// Execute the migration
 			util.LogAlertf("SQL: Executing Migration: [%s]", statement)
-			result, err = projectDB.Exec(statement)
+			if timeoutEnabled(projectConfig.Migration.Timeout) {
+				result, err = executeWithTimeout(statement, projectConfig.Migration.Timeout)
+			} else {
+				result, err = projectDB.Exec(statement)
+			}
 			if !util.ErrorCheck(err) {
 
 				// Record the result into the step table
 				rowsAffected, err = result.RowsAffected()
 				output = fmt.Sprintf("Row(s) Affected: %d", rowsAffected)
 
+			} else if _, timedOut := err.(TimeoutError); timedOut {
+				output = fmt.Sprintf("SQL Exec Timed Out: %v", err)
 			} else {
 				output = fmt.Sprintf("SQL Exec Failed with Error: %v", err)
 			}
 		}
 	}
 
-	return output, err
+	return output, rowsAffected, err
 }
@@ -0,0 +1,94 @@
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// TimeoutError Reports a statement which was cancelled because it was still running
+// when the step timeout expired, typically while it was waiting for a metadata lock
+type TimeoutError struct {
+	Seconds int64
+	Err     error
+}
+
+// Error Formats the timeout and the error returned by the cancelled statement
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("Statement exceeded the step timeout of [%d] second(s) and was cancelled with KILL QUERY: %v", e.Seconds, e.Err)
+}
+
+// timeoutEnabled Returns true if the execution of statements is limited by a timeout
+func timeoutEnabled(timeout config.Timeout) bool {
+	return timeout.StepSeconds > 0 || timeout.LockWaitSeconds > 0
+}
+
+// executeWithTimeout Executes the statement on a dedicated connection to the Project DB
+// whose session lock waits are limited by the configured timeouts.  A statement which
+// is still running when the step timeout expires is cancelled with KILL QUERY.
+func executeWithTimeout(statement string, timeout config.Timeout) (result sql.Result, err error) {
+	var conn *sql.Conn
+	var connectionID int64
+
+	lockWait := timeout.LockWaitSeconds
+	if lockWait == 0 {
+		lockWait = timeout.StepSeconds
+	}
+
+	// Session variables only apply to a single connection of the pool
+	conn, err = projectDB.Db.Conn(context.Background())
+	if util.ErrorCheckf(err, "Failed to open a connection to the Project DB") {
+		return result, err
+	}
+	defer conn.Close()
+
+	for _, variable := range []string{"lock_wait_timeout", "innodb_lock_wait_timeout"} {
+		_, err = conn.ExecContext(context.Background(), fmt.Sprintf("SET SESSION %s = %d", variable, lockWait))
+		if util.ErrorCheckf(err, "Failed to set the %s of the Project DB session", variable) {
+			return result, err
+		}
+	}
+
+	if timeout.StepSeconds == 0 {
+		return conn.ExecContext(context.Background(), statement)
+	}
+
+	err = conn.QueryRowContext(context.Background(), "SELECT CONNECTION_ID()").Scan(&connectionID)
+	if util.ErrorCheckf(err, "Failed to read the connection id of the Project DB session") {
+		return result, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout.StepSeconds)*time.Second)
+	defer cancel()
+
+	// Cancel the statement from another connection if it's still running at the deadline
+	done := make(chan struct{})
+	killed := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			util.LogWarnf("SQL: Statement exceeded the step timeout of [%d] second(s).  Cancelling the query of connection: [%d]", timeout.StepSeconds, connectionID)
+			_, killErr := projectDB.Db.Exec(fmt.Sprintf("KILL QUERY %d", connectionID))
+			util.ErrorCheckf(killErr, "Failed to cancel the query of connection: [%d]", connectionID)
+			killed <- true
+		case <-done:
+			killed <- false
+		}
+	}()
+
+	result, err = conn.ExecContext(ctx, statement)
+	close(done)
+
+	// A statement which completed as the deadline passed isn't a timeout
+	if <-killed && err != nil {
+		err = TimeoutError{
+			Seconds: timeout.StepSeconds,
+			Err:     err,
+		}
+	}
+	return result, err
+}
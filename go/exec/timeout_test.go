@@ -0,0 +1,171 @@
+package exec
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-gorp/gorp"
+
+	"github.com/freneticmonkey/migrate/go/config"
+)
+
+// blockingDriver A database driver whose "SLOW" statement blocks until it's
+// cancelled by KILL QUERY, simulating a statement waiting for a metadata lock
+type blockingDriver struct {
+	mutex      sync.Mutex
+	statements []string
+	killed     chan struct{}
+}
+
+func (d *blockingDriver) Open(name string) (driver.Conn, error) {
+	return &blockingConn{driver: d}, nil
+}
+
+func (d *blockingDriver) record(query string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.statements = append(d.statements, query)
+}
+
+type blockingConn struct {
+	driver *blockingDriver
+}
+
+func (c *blockingConn) Prepare(query string) (driver.Stmt, error) {
+	return &blockingStmt{driver: c.driver, query: query}, nil
+}
+
+func (c *blockingConn) Close() error { return nil }
+
+func (c *blockingConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("Transactions aren't supported")
+}
+
+type blockingStmt struct {
+	driver *blockingDriver
+	query  string
+}
+
+func (s *blockingStmt) Close() error  { return nil }
+func (s *blockingStmt) NumInput() int { return -1 }
+
+func (s *blockingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.record(s.query)
+
+	switch {
+	case strings.HasPrefix(s.query, "KILL QUERY"):
+		close(s.driver.killed)
+	case s.query == "SLOW":
+		<-s.driver.killed
+		return nil, fmt.Errorf("Error 1317: Query execution was interrupted")
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *blockingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.record(s.query)
+	return &connectionIDRows{}, nil
+}
+
+// connectionIDRows The result of SELECT CONNECTION_ID()
+type connectionIDRows struct {
+	read bool
+}
+
+func (r *connectionIDRows) Columns() []string { return []string{"CONNECTION_ID()"} }
+func (r *connectionIDRows) Close() error      { return nil }
+
+func (r *connectionIDRows) Next(dest []driver.Value) error {
+	if r.read {
+		return io.EOF
+	}
+	r.read = true
+	dest[0] = int64(42)
+	return nil
+}
+
+func TestExecuteWithTimeout(t *testing.T) {
+	var tests = []struct {
+		Description        string
+		Statement          string
+		Timeout            config.Timeout
+		ExpectedTimeout    bool
+		ExpectedStatements []string
+	}{
+		{
+			Description: "Lock wait timeout only",
+			Statement:   "FAST",
+			Timeout: config.Timeout{
+				LockWaitSeconds: 5,
+			},
+			ExpectedStatements: []string{
+				"SET SESSION lock_wait_timeout = 5",
+				"SET SESSION innodb_lock_wait_timeout = 5",
+				"FAST",
+			},
+		},
+		{
+			Description: "Statement completes before the step timeout",
+			Statement:   "FAST",
+			Timeout: config.Timeout{
+				StepSeconds: 30,
+			},
+			ExpectedStatements: []string{
+				"SET SESSION lock_wait_timeout = 30",
+				"SET SESSION innodb_lock_wait_timeout = 30",
+				"SELECT CONNECTION_ID()",
+				"FAST",
+			},
+		},
+		{
+			Description: "Blocked statement is cancelled",
+			Statement:   "SLOW",
+			Timeout: config.Timeout{
+				StepSeconds:     1,
+				LockWaitSeconds: 10,
+			},
+			ExpectedTimeout: true,
+			ExpectedStatements: []string{
+				"SET SESSION lock_wait_timeout = 10",
+				"SET SESSION innodb_lock_wait_timeout = 10",
+				"SELECT CONNECTION_ID()",
+				"SLOW",
+				"KILL QUERY 42",
+			},
+		},
+	}
+
+	for i, test := range tests {
+		blocking := &blockingDriver{killed: make(chan struct{})}
+		driverName := fmt.Sprintf("blocking%d", i)
+		sql.Register(driverName, blocking)
+
+		db, err := sql.Open(driverName, "")
+		if err != nil {
+			t.Fatalf("%s FAILED. Unable to open the blocking driver: %v", test.Description, err)
+		}
+		SetProjectDB(&gorp.DbMap{Db: db})
+
+		_, err = executeWithTimeout(test.Statement, test.Timeout)
+
+		_, timedOut := err.(TimeoutError)
+		if timedOut != test.ExpectedTimeout {
+			t.Errorf("%s FAILED. Expected Timeout: %t Error: %v", test.Description, test.ExpectedTimeout, err)
+		}
+		if !test.ExpectedTimeout && err != nil {
+			t.Errorf("%s FAILED with error: %v", test.Description, err)
+		}
+
+		if !reflect.DeepEqual(blocking.statements, test.ExpectedStatements) {
+			t.Errorf("%s FAILED. Expected Statements: %v Got: %v", test.Description, test.ExpectedStatements, blocking.statements)
+		}
+
+		db.Close()
+	}
+}
@@ -2,7 +2,9 @@ package exec
 
 import (
 	"fmt"
+	"os/user"
 
+	"github.com/freneticmonkey/migrate/go/lock"
 	"github.com/freneticmonkey/migrate/go/migration"
 	"github.com/freneticmonkey/migrate/go/util"
 )
@@ -20,3 +22,14 @@ func InProgressID() (inProgressID int64, err error) {
 	}
 	return inProgressID, err
 }
+
+// appliedBy Returns the user and host applying a Migration
+func appliedBy() string {
+	host, _ := lock.Owner()
+
+	name := "unknown"
+	if current, err := user.Current(); err == nil {
+		name = current.Username
+	}
+	return fmt.Sprintf("%s@%s", name, host)
+}
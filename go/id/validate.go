@@ -222,6 +222,16 @@ func ValidateSchema(tables table.Tables, schemaName string, log bool) (validatio
 		for _, index := range tbl.SecondaryIndexes {
 			validate(index.Metadata.PropertyID, index.Metadata.Type, index.Name, tbl.Name, tbl.Filename, &tablePropertyIds, &validationErrors)
 		}
+
+		// Check foreign keys
+		for _, fk := range tbl.ForeignKeys {
+			validate(fk.Metadata.PropertyID, fk.Metadata.Type, fk.Name, tbl.Name, tbl.Filename, &tablePropertyIds, &validationErrors)
+		}
+
+		// Check check constraints
+		for _, chk := range tbl.CheckConstraints {
+			validate(chk.Metadata.PropertyID, chk.Metadata.Type, chk.Name, tbl.Name, tbl.Filename, &tablePropertyIds, &validationErrors)
+		}
 	}
 
 	// Display validation output
@@ -334,6 +344,52 @@ func ValidatePropertyIDs(yamlSchema []table.Table, mysqlSchema []table.Table, lo
 						}
 					}
 				}
+
+				// Check the YAML Foreign Keys
+				for _, yFK := range yTable.ForeignKeys {
+					for _, msFK := range msTable.ForeignKeys {
+						if yFK.Name == msFK.Name {
+							if yFK.Metadata.PropertyID != msFK.Metadata.PropertyID {
+								validationErrors.Add(ValidationError{
+									Desc: fmt.Sprintf("YAML PropertyID change detected. MySQL ID: [%s]", msFK.Metadata.PropertyID),
+									Items: []ValidationItem{
+										{
+											Context: "CHANGED_ID",
+											ID:      yFK.Metadata.PropertyID,
+											Name:    yFK.Name,
+											Table:   yTable.Name,
+											Type:    "ForeignKey",
+											Source:  yTable.Filename,
+										},
+									},
+								})
+							}
+						}
+					}
+				}
+
+				// Check the YAML Check Constraints
+				for _, yChk := range yTable.CheckConstraints {
+					for _, msChk := range msTable.CheckConstraints {
+						if yChk.Name == msChk.Name {
+							if yChk.Metadata.PropertyID != msChk.Metadata.PropertyID {
+								validationErrors.Add(ValidationError{
+									Desc: fmt.Sprintf("YAML PropertyID change detected. MySQL ID: [%s]", msChk.Metadata.PropertyID),
+									Items: []ValidationItem{
+										{
+											Context: "CHANGED_ID",
+											ID:      yChk.Metadata.PropertyID,
+											Name:    yChk.Name,
+											Table:   yTable.Name,
+											Type:    "CheckConstraint",
+											Source:  yTable.Filename,
+										},
+									},
+								})
+							}
+						}
+					}
+				}
 			}
 		}
 	}
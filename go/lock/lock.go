@@ -0,0 +1,229 @@
+package lock
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// HeartbeatInterval How often the owner of a Lock refreshes its heartbeat
+var HeartbeatInterval = 10 * time.Second
+
+// Expiry How long a Lock is held without a heartbeat before another process
+// can take it over
+var Expiry = 60 * time.Second
+
+// Lock The lease on applying Migrations to a target database
+type Lock struct {
+	Name      string `db:"name" json:"name"`
+	Host      string `db:"host" json:"host"`
+	PID       int    `db:"pid" json:"pid"`
+	MID       int64  `db:"mid" json:"mid"`
+	Acquired  string `db:"acquired" json:"acquired"`
+	Heartbeat string `db:"heartbeat" json:"heartbeat"`
+	// Age The number of seconds since the last heartbeat
+	Age     int64 `db:"age" json:"age"`
+	Expired bool  `db:"-" json:"expired"`
+}
+
+// Owner Returns the host and process id which hold the Lock
+func (l Lock) Owner() string {
+	return fmt.Sprintf("%s:%d", l.Host, l.PID)
+}
+
+// Lease A Lock held by this process.  The heartbeat of the Lock is refreshed in
+// the background until the Lease is released.
+type Lease struct {
+	Lock
+	stop chan bool
+	done sync.WaitGroup
+	lost bool
+	mu   sync.Mutex
+}
+
+// Name Returns the name of the Lock for the configured target database
+func Name() string {
+	return fmt.Sprintf("target_database:%d", targetDBID)
+}
+
+// Owner Returns the host and process id of this process
+func Owner() (host string, pid int) {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return host, os.Getpid()
+}
+
+// Acquire Atomically acquire the Lock for applying the Migration to the target
+// database.  A Lock whose heartbeat has expired is taken over.  An error is returned
+// if the Lock is held by another process.
+func Acquire(mid int64) (lease *Lease, err error) {
+	var rows int64
+	var current Lock
+	var held bool
+
+	if err = configured(); err != nil {
+		return lease, err
+	}
+
+	host, pid := Owner()
+	name := Name()
+
+	// The primary key on the name guarantees that only one process can insert the Lock
+	result, err := mgmtDb.Exec("INSERT IGNORE INTO `migration_lock` (`name`, `host`, `pid`, `mid`, `acquired`, `heartbeat`) VALUES (?, ?, ?, ?, NOW(), NOW())", name, host, pid, mid)
+	if util.ErrorCheckf(err, "Unable to acquire the Migration Lock: [%s]", name) {
+		return lease, err
+	}
+	rows, err = result.RowsAffected()
+	if err != nil {
+		return lease, err
+	}
+
+	// The Lock is held, take it over if its heartbeat has expired
+	if rows == 0 {
+		result, err = mgmtDb.Exec("UPDATE `migration_lock` SET `host` = ?, `pid` = ?, `mid` = ?, `acquired` = NOW(), `heartbeat` = NOW() WHERE `name` = ? AND `heartbeat` < NOW() - INTERVAL ? SECOND", host, pid, mid, name, int64(Expiry.Seconds()))
+		if util.ErrorCheckf(err, "Unable to acquire the Migration Lock: [%s]", name) {
+			return lease, err
+		}
+		rows, err = result.RowsAffected()
+		if err != nil {
+			return lease, err
+		}
+
+		if rows == 0 {
+			current, held, err = Status()
+			if err == nil && held {
+				err = fmt.Errorf("Migration Lock: [%s] is held by: [%s] applying Migration: [%d] since: [%s]", name, current.Owner(), current.MID, current.Acquired)
+			} else if err == nil {
+				err = fmt.Errorf("Migration Lock: [%s] is held by another process", name)
+			}
+			return lease, err
+		}
+		util.LogWarnf("Migration Lock: [%s] had expired and was taken over", name)
+	}
+
+	lease = &Lease{
+		Lock: Lock{
+			Name: name,
+			Host: host,
+			PID:  pid,
+			MID:  mid,
+		},
+		stop: make(chan bool),
+	}
+	lease.done.Add(1)
+	go lease.heartbeat()
+
+	util.LogInfof("Acquired Migration Lock: [%s]", name)
+
+	return lease, err
+}
+
+// heartbeat Refreshes the heartbeat of the Lock until the Lease is released
+func (l *Lease) heartbeat() {
+	defer l.done.Done()
+
+	ticker := time.NewTicker(HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			result, err := mgmtDb.Exec("UPDATE `migration_lock` SET `heartbeat` = NOW() WHERE `name` = ? AND `host` = ? AND `pid` = ?", l.Name, l.Host, l.PID)
+			if util.ErrorCheckf(err, "Unable to refresh the heartbeat of the Migration Lock: [%s]", l.Name) {
+				continue
+			}
+			if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+				util.LogErrorf("Migration Lock: [%s] was lost", l.Name)
+				l.mu.Lock()
+				l.lost = true
+				l.mu.Unlock()
+				return
+			}
+		}
+	}
+}
+
+// Lost Returns true if the Lock was taken over by another process
+func (l *Lease) Lost() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lost
+}
+
+// Release Stop refreshing the heartbeat and release the Lock if it's still held
+func (l *Lease) Release() (err error) {
+	close(l.stop)
+	l.done.Wait()
+
+	_, err = mgmtDb.Exec("DELETE FROM `migration_lock` WHERE `name` = ? AND `host` = ? AND `pid` = ?", l.Name, l.Host, l.PID)
+	if !util.ErrorCheckf(err, "Unable to release the Migration Lock: [%s]", l.Name) {
+		util.LogInfof("Released Migration Lock: [%s]", l.Name)
+	}
+	return err
+}
+
+// Status Returns the Lock for the configured target database.  held is false if
+// no process holds the Lock.
+func Status() (lock Lock, held bool, err error) {
+	var locks []Lock
+
+	if err = configured(); err != nil {
+		return lock, held, err
+	}
+
+	query := fmt.Sprintf("SELECT `name`, `host`, `pid`, `mid`, `acquired`, `heartbeat`, TIMESTAMPDIFF(SECOND, `heartbeat`, NOW()) AS `age` FROM `migration_lock` WHERE `name` = '%s'", Name())
+	_, err = mgmtDb.Select(&locks, query)
+	if util.ErrorCheckf(err, "Unable to read the Migration Lock: [%s]", Name()) {
+		return lock, held, err
+	}
+
+	if len(locks) > 0 {
+		lock = locks[0]
+		lock.Expired = lock.Age > int64(Expiry.Seconds())
+		held = true
+	}
+
+	return lock, held, err
+}
+
+// Release Release the Lock for the configured target database regardless of which
+// process holds it.  Unless forced, the Lock is only released once its heartbeat has
+// expired so that a running Migration can't lose its Lock.
+func Release(force bool) (released bool, err error) {
+	var rows int64
+	var result sql.Result
+	var current Lock
+	var held bool
+
+	if err = configured(); err != nil {
+		return released, err
+	}
+
+	if force {
+		result, err = mgmtDb.Exec("DELETE FROM `migration_lock` WHERE `name` = ?", Name())
+	} else {
+		result, err = mgmtDb.Exec("DELETE FROM `migration_lock` WHERE `name` = ? AND `heartbeat` < NOW() - INTERVAL ? SECOND", Name(), int64(Expiry.Seconds()))
+	}
+	if util.ErrorCheckf(err, "Unable to release the Migration Lock: [%s]", Name()) {
+		return released, err
+	}
+	rows, err = result.RowsAffected()
+	if err != nil || rows > 0 || force {
+		return rows > 0, err
+	}
+
+	// Report the process still holding the Lock
+	current, held, err = Status()
+	if err == nil && held {
+		err = fmt.Errorf("Migration Lock: [%s] is held by: [%s] applying Migration: [%d] and hasn't expired. Last heartbeat: %d seconds ago", Name(), current.Owner(), current.MID, current.Age)
+	}
+	return released, err
+}
@@ -0,0 +1,169 @@
+package lock
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/freneticmonkey/migrate/go/test"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestAcquire(t *testing.T) {
+	host, pid := Owner()
+	lockColumns := []string{"name", "host", "pid", "mid", "acquired", "heartbeat", "age"}
+
+	var tests = []struct {
+		Description   string
+		Inserted      int64
+		TakenOver     int64
+		Held          []test.DBRow
+		ExpectedError string
+	}{
+		{
+			Description: "Acquire an unheld lock",
+			Inserted:    1,
+		},
+		{
+			Description: "Take over an expired lock",
+			Inserted:    0,
+			TakenOver:   1,
+		},
+		{
+			Description: "Fail to acquire a lock held by another process",
+			Inserted:    0,
+			TakenOver:   0,
+			Held: []test.DBRow{
+				{"target_database:1", "runner-2", 4321, 7, "2016-07-12 12:04:05", "2016-07-12 12:04:35", 5},
+			},
+			ExpectedError: "is held by: [runner-2:4321] applying Migration: [7]",
+		},
+	}
+
+	for _, tst := range tests {
+		mgmtDB, err := test.CreateManagementDB(tst.Description, t)
+		if err != nil {
+			return
+		}
+		Setup(mgmtDB.Db, 1)
+
+		mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `migration_lock`")).
+			WithArgs("target_database:1", host, pid, 3).
+			WillReturnResult(sqlmock.NewResult(0, tst.Inserted))
+
+		if tst.Inserted == 0 {
+			mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("UPDATE `migration_lock`")).
+				WithArgs(host, pid, 3, "target_database:1", int64(Expiry.Seconds())).
+				WillReturnResult(sqlmock.NewResult(0, tst.TakenOver))
+		}
+
+		if len(tst.ExpectedError) > 0 {
+			mgmtDB.ExpectQuery(test.DBQueryMock{
+				Query:   fmt.Sprintf("FROM `migration_lock` WHERE `name` = '%s'", Name()),
+				Columns: lockColumns,
+				Rows:    tst.Held,
+			})
+		} else {
+			mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `migration_lock`")).
+				WithArgs("target_database:1", host, pid).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		lease, err := Acquire(3)
+
+		if len(tst.ExpectedError) > 0 {
+			if err == nil || !strings.Contains(err.Error(), tst.ExpectedError) {
+				t.Errorf("%s FAILED. Expected error: [%s] Got: [%v]", tst.Description, tst.ExpectedError, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s FAILED with error: %v", tst.Description, err)
+		} else {
+			if lease.Owner() != fmt.Sprintf("%s:%d", host, os.Getpid()) {
+				t.Errorf("%s FAILED. Unexpected owner: [%s]", tst.Description, lease.Owner())
+			}
+			lease.Release()
+		}
+
+		mgmtDB.ExpectionsMet(tst.Description, t)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	lockColumns := []string{"name", "host", "pid", "mid", "acquired", "heartbeat", "age"}
+
+	var tests = []struct {
+		Description   string
+		Force         bool
+		Deleted       int64
+		Held          []test.DBRow
+		Released      bool
+		ExpectedError string
+	}{
+		{
+			Description: "Release an expired lock",
+			Deleted:     1,
+			Released:    true,
+		},
+		{
+			Description: "Release an unheld lock",
+			Deleted:     0,
+		},
+		{
+			Description: "Fail to release a lock which hasn't expired",
+			Deleted:     0,
+			Held: []test.DBRow{
+				{"target_database:1", "runner-2", 4321, 7, "2016-07-12 12:04:05", "2016-07-12 12:04:35", 5},
+			},
+			ExpectedError: "is held by: [runner-2:4321] applying Migration: [7] and hasn't expired",
+		},
+		{
+			Description: "Force the release of a lock which hasn't expired",
+			Force:       true,
+			Deleted:     1,
+			Released:    true,
+		},
+	}
+
+	for _, tst := range tests {
+		mgmtDB, err := test.CreateManagementDB(tst.Description, t)
+		if err != nil {
+			return
+		}
+		Setup(mgmtDB.Db, 1)
+
+		if tst.Force {
+			mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `migration_lock` WHERE `name` = ?")).
+				WithArgs("target_database:1").
+				WillReturnResult(sqlmock.NewResult(0, tst.Deleted))
+		} else {
+			mgmtDB.Mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `migration_lock` WHERE `name` = ? AND `heartbeat` < NOW() - INTERVAL ? SECOND")).
+				WithArgs("target_database:1", int64(Expiry.Seconds())).
+				WillReturnResult(sqlmock.NewResult(0, tst.Deleted))
+		}
+
+		if tst.Deleted == 0 {
+			mgmtDB.ExpectQuery(test.DBQueryMock{
+				Query:   fmt.Sprintf("FROM `migration_lock` WHERE `name` = '%s'", Name()),
+				Columns: lockColumns,
+				Rows:    tst.Held,
+			})
+		}
+
+		released, err := Release(tst.Force)
+
+		if len(tst.ExpectedError) > 0 {
+			if err == nil || !strings.Contains(err.Error(), tst.ExpectedError) {
+				t.Errorf("%s FAILED. Expected error: [%s] Got: [%v]", tst.Description, tst.ExpectedError, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s FAILED with error: %v", tst.Description, err)
+		}
+		if released != tst.Released {
+			t.Errorf("%s FAILED. Expected released: [%t] Got: [%t]", tst.Description, tst.Released, released)
+		}
+
+		mgmtDB.ExpectionsMet(tst.Description, t)
+	}
+}
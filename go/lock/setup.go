@@ -0,0 +1,50 @@
+package lock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/go-gorp/gorp"
+)
+
+var mgmtDb *gorp.DbMap
+var targetDBID int
+
+// Setup Setup the Migration Lock table in the management DB
+func Setup(db *gorp.DbMap, targetDatabaseID int) {
+	mgmtDb = db
+	targetDBID = targetDatabaseID
+}
+
+// CreateTables Create the Migration Lock table if it doesn't already exist, so
+// that existing management databases are upgraded automatically
+func CreateTables() (result bool, err error) {
+
+	createTable := []string{
+		"CREATE TABLE IF NOT EXISTS `migration_lock` (",
+		"  `name` varchar(255) NOT NULL,",
+		"  `host` varchar(255) NOT NULL,",
+		"  `pid` int(11) NOT NULL,",
+		"  `mid` bigint(20) NOT NULL,",
+		"  `acquired` datetime NOT NULL,",
+		"  `heartbeat` datetime NOT NULL,",
+		"  PRIMARY KEY (`name`)",
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8;",
+	}
+	statement := strings.Join(createTable, "\n")
+
+	_, err = mgmtDb.Exec(statement)
+
+	result = !util.ErrorCheckf(err, "Problem creating Migration Lock table in the management DB")
+
+	return result, err
+}
+
+// configured Internal Helper function for checking database validity
+func configured() error {
+	if mgmtDb != nil && mgmtDb.Db != nil && targetDBID > 0 {
+		return nil
+	}
+	return fmt.Errorf("Lock: Database not configured.")
+}
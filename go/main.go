@@ -29,6 +29,10 @@ func main() {
 		cmd.GetValidateCommand(),
 		cmd.GetCreateCommand(),
 		cmd.GetExecCommand(),
+		cmd.GetPlanCommand(),
+		cmd.GetHistoryCommand(),
+		cmd.GetPromoteCommand(),
+		cmd.GetLockCommand(),
 		cmd.GetServeCommand(),
 	}
 
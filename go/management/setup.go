@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/freneticmonkey/migrate/go/approval"
 	"github.com/freneticmonkey/migrate/go/config"
 	"github.com/freneticmonkey/migrate/go/database"
 	"github.com/freneticmonkey/migrate/go/exec"
+	"github.com/freneticmonkey/migrate/go/lock"
 	"github.com/freneticmonkey/migrate/go/metadata"
 	"github.com/freneticmonkey/migrate/go/migration"
 	"github.com/freneticmonkey/migrate/go/mysql"
@@ -32,7 +34,15 @@ func tablesExist() bool {
 	if err != nil {
 		return false
 	}
-	return len(dbTables) == len(tables)
+
+	// The migration_lock and migration_approval tables are created, and the migration tables
+	// upgraded, by Setup so that existing management databases are upgraded
+	for _, table := range tables {
+		if !util.StringInArray(table, dbTables) {
+			return false
+		}
+	}
+	return true
 }
 
 // SetManagementDB Used to set a configured gorp.DbMap so that Unit Tests
@@ -98,7 +108,23 @@ func Setup(conf config.Config) (err error) {
 		mysql.Setup(conf)
 		metadata.Setup(mgmtDb, tdb.DBID)
 		migration.Setup(mgmtDb, tdb.DBID)
-		exec.Setup(mgmtDb, tdb.DBID, conf.Project.DB.ConnectString())
+		exec.Setup(mgmtDb, tdb.DBID, conf.Project)
+		lock.Setup(mgmtDb, tdb.DBID)
+		approval.Setup(mgmtDb)
+
+		err = migration.UpgradeTables()
+		if util.ErrorCheckf(err, "Failed to upgrade the Migration tables in the management DB") {
+			return err
+		}
+
+		_, err = lock.CreateTables()
+		if util.ErrorCheckf(err, "Failed to create Migration Lock table in the management DB") {
+			return err
+		}
+		_, err = approval.CreateTables()
+		if util.ErrorCheckf(err, "Failed to create Migration Approval table in the management DB") {
+			return err
+		}
 		util.LogInfo("Connected to Management DB")
 	}
 
@@ -138,6 +164,18 @@ func BuildSchema(conf config.Config) (err error) {
 			return err
 		}
 
+		lock.Setup(mgmtDb, 0)
+		_, err = lock.CreateTables()
+		if util.ErrorCheckf(err, "Failed to create Migration Lock table in the management DB") {
+			return err
+		}
+
+		approval.Setup(mgmtDb)
+		_, err = approval.CreateTables()
+		if util.ErrorCheckf(err, "Failed to create Migration Approval table in the management DB") {
+			return err
+		}
+
 		util.LogInfo("Successfully Created Management database schema.")
 
 	} else {
@@ -1,12 +1,16 @@
 package metadata
 
 import (
+	"database/sql"
 	"fmt"
 
 	"github.com/freneticmonkey/migrate/go/test"
 	"github.com/freneticmonkey/migrate/go/util"
 )
 
+// schemaObjectTypes The Metadata Types of schema objects which don't belong to a Table
+var schemaObjectTypes = []string{"Table", "View", "Trigger", "Procedure", "Function", "Event"}
+
 // Metadata This struct stores the identification information for each table
 // and table field in the target database.  This data is used to match the
 // schema of the target database to the YAML schema
@@ -26,7 +30,7 @@ func (m *Metadata) Insert() error {
 	if len(m.PropertyID) == 0 {
 		return fmt.Errorf("Inserting empty Metadata")
 	}
-	if len(m.ParentID) == 0 && m.Type != "Table" {
+	if len(m.ParentID) == 0 && !util.StringInArray(m.Type, schemaObjectTypes) {
 		return fmt.Errorf("Inserting Table field Metadata with no ParentID. PropertyID: [%s] Name: [%s] ParentID: [%s]", m.PropertyID, m.Name, m.ParentID)
 	}
 	if err := configured(); err != nil {
@@ -77,7 +81,7 @@ func (m *Metadata) Delete() (err error) {
 	return err
 }
 
-// IsTable Returns if there is a value for ParentID. If empty the property is a table.
+// IsTable Returns if there is a value for ParentID. If empty the property is a table or a view.
 func (m *Metadata) IsTable() bool {
 	return len(m.ParentID) == 0
 }
@@ -140,6 +144,32 @@ func Load(mdid int64) (m *Metadata, err error) {
 	return m, err
 }
 
+// Promote Returns the Metadata of the target database: db matching the Metadata: mdid
+// of another target database.  If the target database doesn't know the property yet, a
+// copy is registered for it with the existence state it has before the property is migrated.
+func Promote(mdid int64, db int, exists bool) (md Metadata, err error) {
+	var source *Metadata
+
+	source, err = Load(mdid)
+	if err != nil {
+		return md, err
+	}
+
+	query := fmt.Sprintf("SELECT * FROM metadata WHERE db = %d AND property_id=\"%s\" AND parent_id=\"%s\"", db, source.PropertyID, source.ParentID)
+	err = mgmtDb.SelectOne(&md, query)
+
+	if err == sql.ErrNoRows {
+		md = *source
+		md.MDID = 0
+		md.DB = db
+		md.Exists = exists
+		err = mgmtDb.Insert(&md)
+	}
+	util.ErrorCheckf(err, "Failed to register Metadata: [%d] with the Target Database: [%d]", mdid, db)
+
+	return md, err
+}
+
 // TableRegistered Returns a boolean indicating that the Table named 'name' is
 // registered in the Metadata table
 func TableRegistered(name string) (reg bool, err error) {
@@ -23,6 +23,10 @@ type Migration struct {
 	VersionDescription string `db:"version_description,size:512" json:"version_description"`
 	Status             int    `db:"status" json:"status"`
 	VettedBy		   string `db:"vetted_by" json:"vetted_by"`
+	AppliedAt          string `db:"applied_at" json:"applied_at"`
+	AppliedBy          string `db:"applied_by" json:"applied_by"`
+	// PromotedFrom The Migration of the previous environment this Migration was promoted from
+	PromotedFrom       int64  `db:"promoted_from" json:"promoted_from"`
 	Timestamp          string `db:"timestamp" json:"timestamp"`
 
 	Steps   []Step `db:"-" json:"steps"`
@@ -63,7 +67,7 @@ func (m *Migration) Update() (err error) {
 	if err == nil {
 		for i := 0; i < len(m.Steps); i++ {
 			err = m.Steps[i].Update()
-			if !util.ErrorCheckf(err, "Updating Migration Step into the DB failed for Project: [%s] with Version: [%s]", m.Project, m.Version) {
+			if util.ErrorCheckf(err, "Updating Migration Step into the DB failed for Project: [%s] with Version: [%s]", m.Project, m.Version) {
 				break
 			}
 		}
@@ -82,10 +86,24 @@ func (m Migration) ToDBRow() test.DBRow {
 		m.VersionDescription,
 		m.Status,
 		m.VettedBy,
+		m.AppliedAt,
+		m.AppliedBy,
+		m.PromotedFrom,
 		m.Timestamp,
 	}
 }
 
+// Author Returns the author of the Git version from the Git details of the Migration
+func (m Migration) Author() string {
+	for _, line := range strings.Split(m.VersionDescription, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "Author:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "Author:"))
+		}
+	}
+	return ""
+}
+
 // Load Load a migation from the DB using the Migration ID primary key
 func Load(mid int64) (m *Migration, err error) {
 
@@ -208,16 +226,16 @@ func Print(mid int64) (err error) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.Debug)
 
 	fmt.Fprintf(w, "---- Migration: %d ----\n", mid)
-	fmt.Fprintln(w, "Project\tVersion\tVersion Timestamp (Git)\tVersion Description\tStatus\tVettedBy\tLast Modified")
-	fmt.Fprintf(w, "|%s\t%s\t%s\t%s\t%s\t%s|\n", m.Project, m.Version, m.VersionTimestamp, m.VersionDescription, StatusString[m.Status], m.VettedBy, m.Timestamp)
+	fmt.Fprintln(w, "Project\tVersion\tVersion Timestamp (Git)\tVersion Description\tStatus\tVettedBy\tApplied At\tApplied By\tPromoted From\tLast Modified")
+	fmt.Fprintf(w, "|%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s|\n", m.Project, m.Version, m.VersionTimestamp, m.VersionDescription, StatusString[m.Status], m.VettedBy, m.AppliedAt, m.AppliedBy, m.PromotedFrom, m.Timestamp)
 	w.Flush()
 
 	fmt.Fprintln(w, "")
 
 	fmt.Fprintln(w, " --- Steps ---")
-	fmt.Fprintln(w, "|#\tID\tOp Type\tMetadata ID\tName\tForward\tBackward\tOutput\tStatus\tVettedBy|")
+	fmt.Fprintln(w, "|#\tID\tOp Type\tMetadata ID\tName\tForward\tBackward\tOutput\tStatus\tVettedBy\tStarted\tFinished\tDuration\tHost\tExecutor\tRows Affected|")
 	for i, step := range m.Steps {
-		fmt.Fprintf(w, "|%d\t%d\t%s\t%d\t%s\t%s\t%s\t%s\t%s|\n",
+		fmt.Fprintf(w, "|%d\t%d\t%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d|\n",
 			i,
 			step.SID,
 			table.OpString[step.Op],
@@ -228,6 +246,12 @@ func Print(mid int64) (err error) {
 			step.Output,
 			StatusString[step.Status],
 			step.VettedBy,
+			step.Started,
+			step.Finished,
+			step.DurationString(),
+			step.Host,
+			step.Executor,
+			step.RowsAffected,
 		)
 	}
 	fmt.Fprintln(w, "")
@@ -236,3 +260,26 @@ func Print(mid int64) (err error) {
 
 	return err
 }
+
+// PrintHistory Print the applied Steps to Stdout
+func PrintHistory(steps []Step) {
+	const padding = 3
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.Debug)
+
+	fmt.Fprintln(w, "|Migration\tStep\tName\tStatus\tStarted\tDuration\tHost\tExecutor\tRows Affected\tForward|")
+	for _, step := range steps {
+		fmt.Fprintf(w, "|%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s|\n",
+			step.MID,
+			step.SID,
+			step.Name,
+			StatusString[step.Status],
+			step.Started,
+			step.DurationString(),
+			step.Host,
+			step.Executor,
+			step.RowsAffected,
+			step.Forward,
+		)
+	}
+	w.Flush()
+}
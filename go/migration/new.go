@@ -3,7 +3,9 @@ package migration
 import (
 	"fmt"
 
+	"github.com/freneticmonkey/migrate/go/config"
 	"github.com/freneticmonkey/migrate/go/mysql"
+	"github.com/freneticmonkey/migrate/go/table"
 	"github.com/freneticmonkey/migrate/go/util"
 )
 
@@ -18,6 +20,11 @@ type Param struct {
 	Rollback    bool
 	Sandbox     bool
 	VettedBy	string
+	Coalesce    bool
+	Algorithm   string
+	// Env The environment of the target database and the Promotion rules it must satisfy
+	Env         string
+	Promotion   config.Promotion
 }
 
 // New Migration constructor which also creates Steps and add everything
@@ -29,9 +36,18 @@ func New(p Param) (m Migration, err error) {
 	var isLatest bool
 	var valid bool
 	var existing bool
+	var predecessor Migration
 
 	valid = true
 
+	// Ensure that the version has been promoted through the environments required by the promotion rules
+	if !p.Sandbox && !p.Rollback {
+		predecessor, err = CheckPromotion(p.Project, p.Version, p.Env, p.Promotion)
+		if err != nil {
+			return m, err
+		}
+	}
+
 	// If there are existing Migrations, validate this migration
 	if existing, err = HasMigrations(); existing {
 
@@ -76,6 +92,21 @@ func New(p Param) (m Migration, err error) {
 
 	if valid {
 
+		// Merge the operations altering each table into a single statement
+		if p.Coalesce {
+			p.Forwards, p.Backwards = mysql.CoalesceAlters(p.Forwards, p.Backwards)
+		}
+
+		// Append the online DDL ALGORITHM and LOCK clauses
+		p.Forwards, err = mysql.ApplyAlgorithms(p.Forwards, p.Algorithm)
+		if util.ErrorCheckf(err, "Failed to apply the online DDL strategy to the forward migration") {
+			return m, err
+		}
+		p.Backwards, err = mysql.ApplyAlgorithms(p.Backwards, p.Algorithm)
+		if util.ErrorCheckf(err, "Failed to apply the online DDL strategy to the backward migration") {
+			return m, err
+		}
+
 		// Ensure that the migration actually has steps (not an empty migration)
 		if len(p.Forwards) > 0 {
 			// Insert the Migration and its Steps into the Management DB
@@ -88,10 +119,38 @@ func New(p Param) (m Migration, err error) {
 				Status:             Unapproved,
 				Sandbox:            p.Sandbox,
 				VettedBy:           p.VettedBy,
+				PromotedFrom:       predecessor.MID,
 			}
 
 			for i := 0; i < len(p.Forwards); i++ {
 				forward := p.Forwards[i]
+				backward := p.Backwards[i]
+				operations := []StepOperation{}
+
+				// Insert the metadata of each of the coalesced operations
+				for j := 0; j < len(forward.Operations); j++ {
+					op := &forward.Operations[j]
+
+					err = op.Metadata.OnCreate()
+					if util.ErrorCheckf(err, "Failed to insert Metadata for Migration.") {
+						return m, err
+					}
+
+					operation := StepOperation{
+						Op:       op.Op,
+						MDID:     op.Metadata.MDID,
+						Name:     op.Name,
+						DataLoss: op.DataLoss,
+					}
+					if len(backward.Operations) == len(forward.Operations) {
+						operation.From = renamedFrom(*op, backward.Operations[j])
+					}
+					operations = append(operations, operation)
+
+					if j == 0 {
+						forward.Metadata = op.Metadata
+					}
+				}
 
 				// Insert the metadata
 				err = forward.Metadata.OnCreate()
@@ -99,15 +158,38 @@ func New(p Param) (m Migration, err error) {
 					return m, err
 				}
 
+				// Record the previous name of a renamed object so that a rollback can restore it,
+				// and the data loss check of a narrowed column
+				if len(operations) == 0 {
+					if from := renamedFrom(forward, backward); len(from) > 0 || len(forward.DataLoss) > 0 {
+						operations = append(operations, StepOperation{
+							Op:       forward.Op,
+							MDID:     forward.Metadata.MDID,
+							Name:     forward.Name,
+							From:     from,
+							DataLoss: forward.DataLoss,
+						})
+					}
+				}
+
 				step := Step{
 					Forward:  forward.Statement,
-					Backward: p.Backwards[i].Statement,
+					Backward: backward.Statement,
 					Status:   Unapproved,
 					Op:       forward.Op,
 					MDID:     forward.Metadata.MDID,
 					Name:     forward.Name,
 					VettedBy: p.VettedBy,
+					Safe:     forward.Safe,
+
+					TableName: mysql.StatementTable(forward.Statement),
 				}
+
+				err = step.SetOperations(operations)
+				if util.ErrorCheckf(err, "Failed to record the coalesced operations for Migration.") {
+					return m, err
+				}
+
 				m.AddStep(step)
 			}
 			m.Insert()
@@ -118,3 +200,12 @@ func New(p Param) (m Migration, err error) {
 
 	return m, err
 }
+
+// renamedFrom Returns the previous name of the object renamed by the forward
+// operation, which is the name restored by its backward operation
+func renamedFrom(forward mysql.SQLOperation, backward mysql.SQLOperation) string {
+	if forward.Op == table.Mod && len(backward.Name) > 0 && backward.Name != forward.Name {
+		return backward.Name
+	}
+	return ""
+}
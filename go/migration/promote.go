@@ -0,0 +1,115 @@
+package migration
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// Predecessor Returns the most recent Migration of the Git version which has been applied
+// to a target database of the project in the environment
+func Predecessor(project string, version string, env string) (m Migration, err error) {
+	if err = configured(); err != nil {
+		return m, err
+	}
+
+	query := fmt.Sprintf("SELECT m.* FROM migration m JOIN target_database d ON m.db = d.dbid WHERE d.project = \"%s\" AND d.env = \"%s\" AND m.version = \"%s\" AND m.status IN (%d,%d) ORDER BY m.mid DESC LIMIT 1", project, env, version, Complete, ForcedCI)
+	err = mgmtDb.SelectOne(&m, query)
+
+	if err == sql.ErrNoRows {
+		err = fmt.Errorf("Version: [%s] of Project: [%s] hasn't been Complete in Environment: [%s]", version, project, env)
+	}
+	return m, err
+}
+
+// CheckPromotion Ensures that the Git version is Complete in each of the environments the
+// promotion rules require before it can be migrated in the environment.  Returns the
+// Migration of the environment it's being promoted from, if any.
+func CheckPromotion(project string, version string, env string, promotion config.Promotion) (predecessor Migration, err error) {
+	for _, rule := range promotion.RulesFor(env) {
+		predecessor, err = Predecessor(project, version, rule.Requires)
+		if util.ErrorCheckf(err, "Promotion rule failed. Version: [%s] must be Complete in %s before it can be migrated in %s", version, rule.Requires, env) {
+			return predecessor, fmt.Errorf("Version: [%s] cannot be migrated in %s before it is Complete in %s: %v", version, env, rule.Requires, err)
+		}
+	}
+	return predecessor, err
+}
+
+// Promote Creates a Migration for the target database: db from the Steps of the predecessor
+// Migration, linked to the predecessor.  The Metadata of the Steps is registered with the
+// target database and the Steps need to be approved again before they can be applied.
+func Promote(predecessor *Migration, db int) (m Migration, err error) {
+	var count int64
+	var md metadata.Metadata
+	var ops []StepOperation
+
+	if err = configured(); err != nil {
+		return m, err
+	}
+
+	count, err = mgmtDb.SelectInt(fmt.Sprintf("select count(*) from migration WHERE version = \"%s\" AND db = %d", predecessor.Version, db))
+	if util.ErrorCheckf(err, "Unable to check for existing Migrations of Version: [%s]", predecessor.Version) {
+		return m, err
+	}
+	if count > 0 {
+		return m, fmt.Errorf("Migration with version: [%s] already exists for Target Database: [%d]", predecessor.Version, db)
+	}
+
+	m = Migration{
+		DB:                 db,
+		Project:            predecessor.Project,
+		Version:            predecessor.Version,
+		VersionTimestamp:   predecessor.VersionTimestamp,
+		VersionDescription: predecessor.VersionDescription,
+		Status:             Unapproved,
+		PromotedFrom:       predecessor.MID,
+	}
+
+	for _, source := range predecessor.Steps {
+		// Objects added by the step don't exist yet in the target database
+		md, err = metadata.Promote(source.MDID, db, source.Op != table.Add)
+		if err != nil {
+			return m, err
+		}
+
+		step := Step{
+			Op:       source.Op,
+			MDID:     md.MDID,
+			Name:     source.Name,
+			Forward:  source.Forward,
+			Backward: source.Backward,
+			Status:   Unapproved,
+			Safe:     source.Safe,
+
+			TableName: source.TableName,
+		}
+
+		ops, err = source.GetOperations()
+		if err != nil {
+			return m, err
+		}
+		if len(source.Operations) > 0 {
+			for i := range ops {
+				md, err = metadata.Promote(ops[i].MDID, db, ops[i].Op != table.Add)
+				if err != nil {
+					return m, err
+				}
+				ops[i].MDID = md.MDID
+			}
+
+			err = step.SetOperations(ops)
+			if util.ErrorCheckf(err, "Failed to record the operations of the promoted Step: [%d]", source.SID) {
+				return m, err
+			}
+		}
+
+		m.AddStep(step)
+	}
+
+	err = m.Insert()
+	return m, err
+}
@@ -39,6 +39,9 @@ func CreateTables() (result bool, err error) {
 		"  `version_description` text,",
 		"  `status` int(11) NOT NULL,",
 		"  `vetted_by` varchar(255) NOT NULL,",
+		"  `applied_at` varchar(32) NOT NULL DEFAULT '',",
+		"  `applied_by` varchar(255) NOT NULL DEFAULT '',",
+		"  `promoted_from` bigint(20) NOT NULL DEFAULT 0,",
 		"  `timestamp` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,",
 		"  PRIMARY KEY (`mid`)",
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8;",
@@ -58,11 +61,20 @@ func CreateTables() (result bool, err error) {
 			"  `op` int(11) DEFAULT NULL,",
 			"  `mdid` bigint(20) DEFAULT NULL,",
 			"  `name` varchar(255) DEFAULT NULL,",
-			"  `forward` varchar(255) DEFAULT NULL,",
-			"  `backward` varchar(255) DEFAULT NULL,",
+			"  `forward` text,",
+			"  `backward` text,",
 			"  `output` text,",
 			"  `status` int(11) DEFAULT NULL,",
 			"  `vetted_by` varchar(255) NOT NULL,",
+			"  `safe` tinyint(1) NOT NULL DEFAULT 0,",
+			"  `operations` text,",
+			"  `started` varchar(32) NOT NULL DEFAULT '',",
+			"  `finished` varchar(32) NOT NULL DEFAULT '',",
+			"  `duration_ms` bigint(20) NOT NULL DEFAULT 0,",
+			"  `host` varchar(255) NOT NULL DEFAULT '',",
+			"  `executor` varchar(32) NOT NULL DEFAULT '',",
+			"  `rows_affected` bigint(20) NOT NULL DEFAULT 0,",
+			"  `table_name` varchar(255) NOT NULL DEFAULT '',",
 			"  PRIMARY KEY (`sid`)",
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8;",
 		}
@@ -75,6 +87,83 @@ func CreateTables() (result bool, err error) {
 	return result, err
 }
 
+// schemaColumn A column of the migration tables as described by information_schema
+type schemaColumn struct {
+	Table    string `db:"table_name"`
+	Column   string `db:"column_name"`
+	DataType string `db:"data_type"`
+}
+
+// schemaUpgrade A column which has been added to, or redefined in, the migration
+// tables since they were first released
+type schemaUpgrade struct {
+	Table      string
+	Column     string
+	DataType   string
+	Definition string
+}
+
+// schemaUpgrades The columns which management databases created by earlier releases
+// may be missing, in the order that they are applied
+var schemaUpgrades = []schemaUpgrade{
+	{"migration", "applied_at", "varchar", "varchar(32) NOT NULL DEFAULT ''"},
+	{"migration", "applied_by", "varchar", "varchar(255) NOT NULL DEFAULT ''"},
+	{"migration", "promoted_from", "bigint", "bigint(20) NOT NULL DEFAULT 0"},
+	{"migration_steps", "forward", "text", "text"},
+	{"migration_steps", "backward", "text", "text"},
+	{"migration_steps", "safe", "tinyint", "tinyint(1) NOT NULL DEFAULT 0"},
+	{"migration_steps", "operations", "text", "text"},
+	{"migration_steps", "started", "varchar", "varchar(32) NOT NULL DEFAULT ''"},
+	{"migration_steps", "finished", "varchar", "varchar(32) NOT NULL DEFAULT ''"},
+	{"migration_steps", "duration_ms", "bigint", "bigint(20) NOT NULL DEFAULT 0"},
+	{"migration_steps", "host", "varchar", "varchar(255) NOT NULL DEFAULT ''"},
+	{"migration_steps", "executor", "varchar", "varchar(32) NOT NULL DEFAULT ''"},
+	{"migration_steps", "rows_affected", "bigint", "bigint(20) NOT NULL DEFAULT 0"},
+	{"migration_steps", "table_name", "varchar", "varchar(255) NOT NULL DEFAULT ''"},
+}
+
+// UpgradeTables Add any columns missing from migration tables which were created by
+// an earlier release, and widen any columns whose definitions have changed.  Columns
+// which are already up to date are left untouched, so it is safe to run repeatedly.
+func UpgradeTables() (err error) {
+	var columns []schemaColumn
+
+	query := "SELECT TABLE_NAME AS table_name, COLUMN_NAME AS column_name, DATA_TYPE AS data_type FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = database() AND TABLE_NAME IN ('migration', 'migration_steps')"
+
+	_, err = mgmtDb.Select(&columns, query)
+	if util.ErrorCheckf(err, "Problem reading the columns of the Migration tables in the management DB") {
+		return err
+	}
+
+	existing := map[string]string{}
+	for _, column := range columns {
+		existing[column.Table+"."+column.Column] = strings.ToLower(column.DataType)
+	}
+
+	for _, upgrade := range schemaUpgrades {
+		var statement string
+
+		dataType, ok := existing[upgrade.Table+"."+upgrade.Column]
+
+		if !ok {
+			statement = fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `%s` %s", upgrade.Table, upgrade.Column, upgrade.Definition)
+		} else if dataType != upgrade.DataType {
+			statement = fmt.Sprintf("ALTER TABLE `%s` MODIFY COLUMN `%s` %s", upgrade.Table, upgrade.Column, upgrade.Definition)
+		} else {
+			continue
+		}
+
+		util.LogInfof("Upgrading the management DB: %s", statement)
+
+		_, err = mgmtDb.Exec(statement)
+		if util.ErrorCheckf(err, "Problem upgrading column: [%s.%s] in the management DB", upgrade.Table, upgrade.Column) {
+			return err
+		}
+	}
+
+	return err
+}
+
 // configured Internal Helper function for checking database validity
 func configured() error {
 	if mgmtDb != nil && mgmtDb.Db != nil && projectDBID > 0 {
@@ -1,11 +1,14 @@
 package migration
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/mysql"
 	"github.com/freneticmonkey/migrate/go/table"
 	"github.com/freneticmonkey/migrate/go/test"
 	"github.com/freneticmonkey/migrate/go/util"
@@ -23,6 +26,117 @@ type Step struct {
 	Output   string `db:"output,size:1024" json:"output"`
 	Status   int    `db:"status" json:"status"`
 	VettedBy string `db:"vetted_by" json:"vetted_by"`
+	Safe     bool   `db:"safe" json:"safe"`
+
+	// Operations JSON list of the StepOperations coalesced into this Step
+	Operations string `db:"operations" json:"operations"`
+
+	// Started and Finished The times at which the Step was last applied
+	Started  string `db:"started" json:"started"`
+	Finished string `db:"finished" json:"finished"`
+
+	// The execution of the Step: how long it took in milliseconds, the host which
+	// applied it, the executor used and the number of rows affected
+	DurationMS   int64  `db:"duration_ms" json:"duration_ms"`
+	Host         string `db:"host" json:"host"`
+	Executor     string `db:"executor" json:"executor"`
+	RowsAffected int64  `db:"rows_affected" json:"rows_affected"`
+
+	// TableName The table created, altered or dropped by the Step
+	TableName string `db:"table_name" json:"table_name"`
+}
+
+// StepOperation Stores the Metadata affected by each of the operations which
+// were coalesced into a single Step.  From is the previous name of a renamed
+// object, which is restored to the Metadata when the Step is rolled back.
+// DataLoss is a query counting the rows which would lose data when a column is
+// narrowed by the operation.
+type StepOperation struct {
+	Op       int    `json:"op"`
+	MDID     int64  `json:"mdid"`
+	Name     string `json:"name"`
+	From     string `json:"from,omitempty"`
+	DataLoss string `json:"data_loss,omitempty"`
+}
+
+// SetOperations Record the operations coalesced into the Step
+func (s *Step) SetOperations(ops []StepOperation) (err error) {
+	var data []byte
+
+	s.Operations = ""
+	if len(ops) > 0 {
+		data, err = json.Marshal(ops)
+		s.Operations = string(data)
+	}
+	return err
+}
+
+// GetOperations Returns the operations applied by the Step.  A Step which hasn't
+// been coalesced applies a single operation.
+func (s Step) GetOperations() (ops []StepOperation, err error) {
+	if len(s.Operations) > 0 {
+		err = json.Unmarshal([]byte(s.Operations), &ops)
+		util.ErrorCheckf(err, "Unable to read the coalesced operations of Step: [%d]", s.SID)
+		return ops, err
+	}
+
+	ops = append(ops, StepOperation{
+		Op:   s.Op,
+		MDID: s.MDID,
+		Name: s.Name,
+	})
+	return ops, err
+}
+
+// DataLossChecks Returns the queries which count the rows that would lose data
+// when the Step narrows a column
+func (s Step) DataLossChecks() (checks []string, err error) {
+	var ops []StepOperation
+
+	ops, err = s.GetOperations()
+	if err != nil {
+		return checks, err
+	}
+
+	for _, op := range ops {
+		if len(op.DataLoss) > 0 {
+			checks = append(checks, op.DataLoss)
+		}
+	}
+	return checks, err
+}
+
+// Duration Returns how long the Step took to apply.  ok is false if the Step
+// hasn't finished being applied.
+func (s Step) Duration() (duration time.Duration, ok bool) {
+	if s.DurationMS > 0 && len(s.Finished) > 0 {
+		return time.Duration(s.DurationMS) * time.Millisecond, true
+	}
+
+	started, err := time.Parse(mysql.TimeFormat, s.Started)
+	if err != nil {
+		return duration, false
+	}
+	finished, err := time.Parse(mysql.TimeFormat, s.Finished)
+	if err != nil || finished.Before(started) {
+		return duration, false
+	}
+	return finished.Sub(started), true
+}
+
+// DurationString Formats how long the Step took to apply, or an empty string if
+// it hasn't finished being applied
+func (s Step) DurationString() string {
+	if duration, ok := s.Duration(); ok {
+		return duration.String()
+	}
+	return ""
+}
+
+// IsDestructive Returns true if the Step may remove data.  Only Add steps and
+// Mod steps marked as Safe are non-destructive.
+func (s Step) IsDestructive() bool {
+	return s.Op != table.Add && !s.Safe
 }
 
 // Insert Insert the Step into the Management DB
@@ -54,56 +168,136 @@ func LoadStepsList(sids []int64) (s []Step, err error) {
 	return s, err
 }
 
+// TableDurations Returns how long each of the applied Steps which changed the table
+// of the target database took
+func TableDurations(tableName string) (durations []time.Duration, err error) {
+	var steps []Step
+
+	query := fmt.Sprintf("select s.* from migration_steps s JOIN migration m ON m.mid = s.mid WHERE m.db = %d AND s.status IN (%d,%d) AND s.started <> '' AND s.finished <> ''%s", projectDBID, Complete, ForcedCI, tableFilter(tableName))
+	_, err = mgmtDb.Select(&steps, query)
+
+	if util.ErrorCheckf(err, "There was a problem retrieving the Steps applied to Table: [%s]", tableName) {
+		return durations, err
+	}
+
+	for _, step := range steps {
+		if duration, ok := step.Duration(); ok {
+			durations = append(durations, duration)
+		}
+	}
+	return durations, err
+}
+
+// LoadHistory Returns the Steps most recently applied to the target database, newest
+// first.  If tableName is set only the Steps which changed the table are returned.
+func LoadHistory(tableName string, count int64) (steps []Step, err error) {
+	query := fmt.Sprintf("select s.* from migration_steps s JOIN migration m ON m.mid = s.mid WHERE m.db = %d AND s.started <> ''%s ORDER BY s.started DESC, s.sid DESC LIMIT %d", projectDBID, tableFilter(tableName), count)
+	_, err = mgmtDb.Select(&steps, query)
+
+	util.ErrorCheckf(err, "There was a problem retrieving the history of applied Steps")
+	return steps, err
+}
+
+// tableFilter Returns the condition matching the Steps which changed the table
+func tableFilter(tableName string) string {
+	if len(tableName) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" AND s.table_name = '%s'", strings.Replace(tableName, "'", "''", -1))
+}
+
 // UpdateMetadata Use the Step info to update the database
 func (s *Step) UpdateMetadata() (err error) {
-	var m *metadata.Metadata
+	var ops []StepOperation
 
 	if s.Status == ForcedCI || s.Status == Complete || s.Status == Rollback {
 
-		m, err = metadata.Load(s.MDID)
-		if util.ErrorCheckf(err, "Failed to load Metadata from the database") {
+		ops, err = s.GetOperations()
+		if err != nil {
 			return err
 		}
 
-		switch s.Op {
+		for _, op := range ops {
+			err = s.updateOperationMetadata(op)
+			if err != nil {
+				break
+			}
+		}
+	}
 
-		case table.Add:
-			// Mark exists
-			m.Exists = true
+	return err
+}
 
-			// if rollback apply the reverse
-			if s.Status == Rollback {
-				m.Exists = false
-			}
+// updateOperationMetadata Update the Metadata affected by an operation applied by the Step
+func (s *Step) updateOperationMetadata(op StepOperation) (err error) {
+	var m *metadata.Metadata
 
-			err = m.Update()
+	m, err = metadata.Load(op.MDID)
+	if util.ErrorCheckf(err, "Failed to load Metadata from the database") {
+		return err
+	}
 
-			if m.IsTable() {
-				// Update all table fields to exist
-				metadata.SetTableExists(m.Name)
-			}
+	// Partitions are added and dropped by altering the Table using its Metadata.
+	// These steps don't change the existence of the Table.
+	if m.IsTable() && op.Op != table.Mod && strings.HasPrefix(s.Forward, "ALTER TABLE") {
+		return err
+	}
 
-		case table.Mod:
-			// If a rename has occurred, be sure to update the new name in the Metadata
-			if m.Name != s.Name {
-				m.Name = s.Name
-				err = m.Update()
-			}
-		case table.Del:
+	switch op.Op {
+
+	case table.Add:
+		// Mark exists
+		m.Exists = true
 
-			// Mark not existant
+		// if rollback apply the reverse
+		if s.Status == Rollback {
 			m.Exists = false
+		}
+
+		err = m.Update()
 
-			// if rollback apply the reverse
+		if err == nil && m.IsTable() {
 			if s.Status == Rollback {
-				m.Exists = true
+				// The table has been dropped again, along with all of its fields
+				err = metadata.MarkNonExistAllTableMetadata(m.Name)
+			} else {
+				// Update all table fields to exist
+				err = metadata.SetTableExists(m.Name)
 			}
+		}
+
+	case table.Mod:
+		// If a rename has occurred, be sure to update the new name in the Metadata
+		name := op.Name
 
+		// if rollback restore the previous name
+		if s.Status == Rollback && len(op.From) > 0 {
+			name = op.From
+		}
+
+		if m.Name != name {
+			m.Name = name
 			err = m.Update()
+		}
+	case table.Del:
 
-			if m.IsTable() {
+		// Mark not existant
+		m.Exists = false
+
+		// if rollback apply the reverse
+		if s.Status == Rollback {
+			m.Exists = true
+		}
+
+		err = m.Update()
+
+		if err == nil && m.IsTable() {
+			if s.Status == Rollback {
+				// The table has been recreated, along with all of its fields
+				err = metadata.SetTableExists(m.Name)
+			} else {
 				// Delete all table fields
-				metadata.MarkNonExistAllTableMetadata(m.Name)
+				err = metadata.MarkNonExistAllTableMetadata(m.Name)
 			}
 		}
 	}
@@ -124,5 +318,14 @@ func (s Step) ToDBRow() test.DBRow {
 		s.Output,
 		s.Status,
 		s.VettedBy,
+		s.Safe,
+		s.Operations,
+		s.Started,
+		s.Finished,
+		s.DurationMS,
+		s.Host,
+		s.Executor,
+		s.RowsAffected,
+		s.TableName,
 	}
 }
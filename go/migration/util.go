@@ -8,10 +8,10 @@ import (
 	"github.com/freneticmonkey/migrate/go/util"
 )
 
-// VersionExists Check if the Git version has already been registered for migration
+// VersionExists Check if the Git version has already been registered for migration of the target database
 func VersionExists(hash string) (exists bool, err error) {
 	var count int64
-	query := fmt.Sprintf("select count(*) from migration WHERE version = \"%s\"", hash)
+	query := fmt.Sprintf("select count(*) from migration WHERE version = \"%s\" AND db = %d", hash, projectDBID)
 	count, err = mgmtDb.SelectInt(query)
 	if err == nil {
 		exists = (count > 0)
@@ -41,20 +41,20 @@ func IsLatest(newTime string) (isLatest bool, err error) {
 	return isLatest, err
 }
 
-// HasMigrations There are existing migrations
+// HasMigrations There are existing migrations for the target database
 func HasMigrations() (result bool, err error) {
 	var count int64
-	count, err = mgmtDb.SelectInt("select count(*) from migration")
+	count, err = mgmtDb.SelectInt(fmt.Sprintf("select count(*) from migration WHERE db = %d", projectDBID))
 	if !util.ErrorCheckf(err, "Unable to check for existing Migrations in the Management DB") {
 		result = (count > 0)
 	}
 	return result, err
 }
 
-// GetLatest Return the git timestamp latest Migration from the DB
+// GetLatest Return the git timestamp latest Migration of the target database from the DB
 func GetLatest() (m Migration, err error) {
 	var migrations Migration
-	err = mgmtDb.SelectOne(&migrations, "select * from migration ORDER BY version_timestamp DESC LIMIT 1")
+	err = mgmtDb.SelectOne(&migrations, fmt.Sprintf("select * from migration WHERE db = %d ORDER BY version_timestamp DESC LIMIT 1", projectDBID))
 	if !util.ErrorCheckf(err, "Unable to get latest Migration from Management DB") {
 		m = migrations
 	}
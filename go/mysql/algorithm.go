@@ -0,0 +1,218 @@
+package mysql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/table"
+)
+
+// Online DDL Strategies
+const (
+	// DDLStrategyNone Don't add ALGORITHM or LOCK clauses to the generated statements
+	DDLStrategyNone = ""
+	// DDLStrategyInstant Use INSTANT where possible.  Requires MySQL 8.0.
+	DDLStrategyInstant = "instant"
+	// DDLStrategyInplace Use INPLACE where possible.  For MySQL 5.6 and 5.7.
+	DDLStrategyInplace = "inplace"
+)
+
+// algorithmPattern Matches the ALGORITHM clause of a statement
+var algorithmPattern = regexp.MustCompile("ALGORITHM=(\\w+)")
+
+// algorithmClausePattern Matches the ALGORITHM and LOCK clauses appended to a statement
+var algorithmClausePattern = regexp.MustCompile(",?\\s+ALGORITHM=\\w+(,?\\s+LOCK=\\w+)?;?$")
+
+// algorithms The ALTER TABLE algorithms in order of increasing cost
+var algorithms = []string{AlgorithmInstant, AlgorithmInplace, AlgorithmCopy}
+
+// algorithmCost Returns the relative cost of applying an operation using the algorithm
+func algorithmCost(algorithm string) int {
+	for cost, alg := range algorithms {
+		if alg == algorithm {
+			return cost
+		}
+	}
+	return -1
+}
+
+// classifyAlgorithm Returns the cheapest algorithm which MySQL can use to apply
+// the operation generated from the diff.  Operations which don't alter a table,
+// rename it, or change its partitioning aren't classified.
+func classifyAlgorithm(diff table.Diff, operation SQLOperation) string {
+
+	if _, _, ok := alterClause(operation.Statement); !ok {
+		return ""
+	}
+
+	switch diff.Field {
+
+	case "Columns":
+		switch diff.Op {
+		case table.Add:
+			column, ok := diff.Value.(table.Column)
+			if ok && (column.AutoInc || (column.IsGenerated() && column.Storage == table.ColumnStored)) {
+				return AlgorithmCopy
+			}
+			return AlgorithmInstant
+
+		case table.Del:
+			return AlgorithmInplace
+
+		case table.Mod:
+			diffPair, ok := diff.Value.(table.DiffPair)
+			if !ok {
+				return AlgorithmCopy
+			}
+			toColumn, toOk := diffPair.To.(table.Column)
+			fromColumn, fromOk := diffPair.From.(table.Column)
+			if !toOk || !fromOk {
+				return AlgorithmCopy
+			}
+
+			switch diff.Property {
+			case "Name":
+				if toColumn.RenamesOnly(fromColumn) {
+					return AlgorithmInplace
+				}
+			case "Default":
+				return AlgorithmInstant
+			case "Values":
+				if operation.Safe {
+					return AlgorithmInstant
+				}
+			case "Comment":
+				return AlgorithmInplace
+			}
+			return AlgorithmCopy
+		}
+
+	case "PrimaryIndex":
+		if diff.Op == table.Add {
+			return AlgorithmInplace
+		}
+		return AlgorithmCopy
+
+	case "SecondaryIndexes":
+		index, ok := diff.Value.(table.Index)
+		if diffPair, isPair := diff.Value.(table.DiffPair); isPair {
+			index, ok = diffPair.To.(table.Index)
+		}
+		// The first FULLTEXT or SPATIAL index requires the table to be rebuilt
+		if ok && operation.Op == table.Add && (index.Kind == "FULLTEXT" || index.Kind == "SPATIAL") {
+			return AlgorithmCopy
+		}
+		return AlgorithmInplace
+
+	case "ForeignKeys", "CheckConstraints":
+		// Adding a constraint validates the existing rows
+		if operation.Op == table.Add {
+			return AlgorithmCopy
+		}
+		return AlgorithmInplace
+
+	default:
+		switch diff.Property {
+		case "AutoInc", "Comment":
+			return AlgorithmInplace
+		}
+	}
+
+	return AlgorithmCopy
+}
+
+// coalescedAlgorithm Returns the most expensive algorithm of the operations
+func coalescedAlgorithm(operations SQLOperations) (algorithm string) {
+	for _, op := range operations {
+		if len(op.Algorithm) == 0 {
+			return ""
+		}
+		if algorithmCost(op.Algorithm) > algorithmCost(algorithm) {
+			algorithm = op.Algorithm
+		}
+	}
+	return algorithm
+}
+
+// algorithmClause Formats the ALGORITHM and LOCK clauses for the algorithm.
+// Requesting LOCK=NONE makes MySQL fail immediately if it can't apply the
+// statement without blocking writes, instead of silently locking the table.
+func algorithmClause(algorithm string) string {
+	switch algorithm {
+	case AlgorithmInstant:
+		return "ALGORITHM=INSTANT"
+	case AlgorithmInplace:
+		return "ALGORITHM=INPLACE, LOCK=NONE"
+	case AlgorithmCopy:
+		return "ALGORITHM=COPY, LOCK=SHARED"
+	}
+	return ""
+}
+
+// ApplyAlgorithm Appends the ALGORITHM and LOCK clauses for the classified algorithm
+// of the operation to its statement using the online DDL strategy.
+func ApplyAlgorithm(operation SQLOperation, strategy string) (SQLOperation, error) {
+	algorithm := operation.Algorithm
+
+	switch strings.ToLower(strategy) {
+	case DDLStrategyNone:
+		return operation, nil
+	case DDLStrategyInstant:
+	case DDLStrategyInplace:
+		if algorithm == AlgorithmInstant {
+			algorithm = AlgorithmInplace
+		}
+	default:
+		return operation, fmt.Errorf("Unsupported online DDL strategy: [%s]", strategy)
+	}
+
+	clause := algorithmClause(algorithm)
+	if len(clause) == 0 || len(StatementAlgorithm(operation.Statement)) > 0 {
+		return operation, nil
+	}
+
+	statement := strings.TrimSuffix(strings.TrimSpace(operation.Statement), ";")
+
+	if strings.HasPrefix(statement, "ALTER TABLE") {
+		statement = fmt.Sprintf("%s, %s;", statement, clause)
+	} else {
+		// CREATE INDEX and DROP INDEX separate their options with whitespace
+		statement = fmt.Sprintf("%s %s;", statement, strings.Replace(clause, ",", "", -1))
+	}
+
+	operation.Statement = statement
+	operation.Algorithm = algorithm
+
+	return operation, nil
+}
+
+// ApplyAlgorithms Appends the ALGORITHM and LOCK clauses to each of the operations
+func ApplyAlgorithms(operations SQLOperations, strategy string) (result SQLOperations, err error) {
+	for _, op := range operations {
+		op, err = ApplyAlgorithm(op, strategy)
+		if err != nil {
+			return operations, err
+		}
+		result = append(result, op)
+	}
+	return result, err
+}
+
+// StatementAlgorithm Returns the algorithm requested by the ALGORITHM clause of the statement
+func StatementAlgorithm(statement string) string {
+	if match := algorithmPattern.FindStringSubmatch(statement); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
+// StripAlgorithm Removes the ALGORITHM and LOCK clauses appended to the statement
+// by ApplyAlgorithm.  External schema change tools choose their own algorithm.
+func StripAlgorithm(statement string) string {
+	statement = strings.TrimSpace(statement)
+	if stripped := algorithmClausePattern.ReplaceAllString(statement, ""); stripped != statement {
+		return stripped + ";"
+	}
+	return statement
+}
@@ -16,6 +16,21 @@ type SQLOperation struct {
 	Op        int
 	Name      string
 	Metadata  metadata.Metadata
+
+	// Safe Marks a Mod operation which can't lose any data, such as appending
+	// members to an ENUM, so that it isn't treated as destructive
+	Safe bool
+
+	// Operations The operations which were coalesced into this operation
+	Operations SQLOperations
+
+	// Algorithm The cheapest ALTER TABLE algorithm which MySQL can use to apply
+	// the operation.  Empty if the operation doesn't alter a table.
+	Algorithm string
+
+	// DataLoss A query which counts the rows that would lose data when a column is
+	// narrowed by the operation.  Empty if the operation can't lose column data.
+	DataLoss string
 }
 
 // SQLOperations Slice helper type
@@ -76,25 +91,6 @@ func (sb *StatementBuilder) AddQuote(component string) {
 	}
 }
 
-// AddType Add a component which correctly formats type size it it's provided
-func (sb *StatementBuilder) AddType(typename string, size []int) {
-	// Support for decimal places makes the size a little complicated
-	if typename != "" {
-		colType := ""
-
-		switch len(size) {
-		case 2:
-			colType = fmt.Sprintf("%s(%d,%d)", typename, size[0], size[1])
-		case 1:
-			colType = fmt.Sprintf("%s(%d)", typename, size[0])
-		default:
-			colType = typename
-		}
-		sb.Add(colType)
-	}
-
-}
-
 // Format Produce a formatted String
 func (sb StatementBuilder) Format() string {
 	return strings.Join(sb.Components, " ") + ";"
@@ -136,6 +132,18 @@ func generateCreateTable(tbl table.Table) (operation SQLOperation) {
 		}
 	}
 
+	for _, fk := range tbl.ForeignKeys {
+		if fk.IsValid() {
+			indexes = append(indexes, fk.ToSQL())
+		}
+	}
+
+	for _, chk := range tbl.CheckConstraints {
+		if chk.IsValid() {
+			indexes = append(indexes, chk.ToSQL())
+		}
+	}
+
 	strIndexes := ""
 	if len(indexes) > 0 {
 		strIndexes = ", " + strings.Join(indexes, ",")
@@ -161,6 +169,14 @@ func generateCreateTable(tbl table.Table) (operation SQLOperation) {
 	if len(tbl.Collation) > 0 {
 		builder.AddFormat("COLLATE=%s", tbl.Collation)
 	}
+
+	if len(tbl.Comment) > 0 {
+		builder.AddFormat("COMMENT=%s", util.QuoteSQLString(tbl.Comment))
+	}
+
+	if tbl.Partitioning.IsValid() {
+		builder.Add(tbl.Partitioning.ToSQL())
+	}
 	operation.Statement = builder.Format()
 	operation.Metadata = tbl.Metadata
 	return operation
@@ -187,12 +203,25 @@ func generateAlterColumn(diff table.Diff) (ops SQLOperations) {
 
 		column, ok := diff.Value.(table.Column)
 		if ok {
-			builder.AddType(column.Type, column.Size)
+			builder.Add(column.TypeSQL())
+
+			if column.Unsigned {
+				builder.Add("unsigned")
+			}
+
+			if column.IsGenerated() {
+				builder.Add(column.GeneratedSQL())
+			}
 
 			if !column.Nullable {
 				builder.Add("NOT NULL")
 			}
 
+			// if a spatial reference system is defined
+			if column.SRID > 0 {
+				builder.Add(column.SRIDSQL())
+			}
+
 			// if AutoInc is T or F
 			if column.AutoInc {
 				builder.Add("AUTO_INCREMENT")
@@ -206,6 +235,10 @@ func generateAlterColumn(diff table.Diff) (ops SQLOperations) {
 					builder.AddFormat("DEFAULT '%s'", column.Default)
 				}
 			}
+
+			if len(column.Comment) > 0 {
+				builder.AddFormat("COMMENT %s", util.QuoteSQLString(column.Comment))
+			}
 		}
 
 	case table.Del:
@@ -233,9 +266,28 @@ func generateAlterColumn(diff table.Diff) (ops SQLOperations) {
 
 		builder.AddQuote(diff.Table)
 
-		// Name needs special handling because it requires a different number of components
-		// Assuming that we are modifying the column definition by default
-		if diff.Property == "Name" {
+		// MySQL cannot change the storage type of a generated column in place
+		// so the column is dropped and regenerated from its expression
+		if diff.Property == "Storage" && fromColumn.IsGenerated() && toColumn.IsGenerated() {
+			builder.Add("DROP COLUMN")
+			builder.AddQuote(fromColumn.Name)
+
+			ops.Add(SQLOperation{
+				Statement: builder.Format(),
+				Op:        table.Mod,
+				Name:      fromColumn.Name,
+				Metadata:  diff.Metadata,
+			})
+
+			builder.Reset()
+			builder.Add("ALTER TABLE")
+			builder.AddQuote(diff.Table)
+			builder.Add("ADD COLUMN")
+			builder.AddQuote(toColumn.Name)
+
+		} else if diff.Property == "Name" {
+			// Name needs special handling because it requires a different number of components
+			// Assuming that we are modifying the column definition by default
 			builder.Add("CHANGE COLUMN")
 			builder.AddFormat("`%s` `%s`", fromColumn.Name, toColumn.Name)
 			operation.Name = toColumn.Name
@@ -245,14 +297,26 @@ func generateAlterColumn(diff table.Diff) (ops SQLOperations) {
 			builder.AddQuote(fromColumn.Name)
 		}
 
-		// Support for decimal places makes the size a little complicated
-		builder.AddType(toColumn.Type, toColumn.Size)
+		builder.Add(toColumn.TypeSQL())
+
+		if toColumn.Unsigned {
+			builder.Add("unsigned")
+		}
+
+		if toColumn.IsGenerated() {
+			builder.Add(toColumn.GeneratedSQL())
+		}
 
 		// if Nullable is T or F
 		if !toColumn.Nullable {
 			builder.Add("NOT NULL")
 		}
 
+		// if a spatial reference system is defined
+		if toColumn.SRID > 0 {
+			builder.Add(toColumn.SRIDSQL())
+		}
+
 		// if AutoInc is T or F
 		if toColumn.AutoInc {
 			builder.Add("AUTO_INCREMENT")
@@ -270,6 +334,20 @@ func generateAlterColumn(diff table.Diff) (ops SQLOperations) {
 		if len(toColumn.Collation) > 0 {
 			builder.AddFormat("COLLATE %s", toColumn.Collation)
 		}
+
+		// Appending members to an ENUM or SET doesn't change the existing values
+		operation.Safe = diff.Property == "Values" && toColumn.AppendsValues(fromColumn)
+
+		// Narrowing the column can truncate or clip existing values
+		if conditions := toColumn.DataLossConditions(fromColumn); len(conditions) > 0 {
+			operation.Safe = false
+			operation.DataLoss = fmt.Sprintf("SELECT COUNT(*) FROM `%s` WHERE (%s)", diff.Table, strings.Join(conditions, ") OR ("))
+			util.LogWarnf("Potentially destructive change to Column: [%s.%s] may lose data", diff.Table, fromColumn.Name)
+		}
+
+		if len(toColumn.Comment) > 0 {
+			builder.AddFormat("COMMENT %s", util.QuoteSQLString(toColumn.Comment))
+		}
 	}
 
 	operation.Statement = builder.Format()
@@ -322,11 +400,15 @@ func generateAlterIndex(diff table.Diff) (ops SQLOperations) {
 		}
 
 		builder.Reset()
-		builder.Add("CREATE INDEX")
+		if kind := index.KindSQL(); len(kind) > 0 && !index.IsPrimary {
+			builder.AddFormat("CREATE %s INDEX", kind)
+		} else {
+			builder.Add("CREATE INDEX")
+		}
 		builder.AddQuote(indexName)
 		builder.Add("ON")
 		builder.AddQuote(diff.Table)
-		builder.Add(index.ColumnsSQL())
+		builder.Add(index.ColumnsSQL() + index.OptionsSQL())
 
 		addOp := SQLOperation{
 			Statement: builder.Format(),
@@ -381,6 +463,380 @@ func generateAlterIndex(diff table.Diff) (ops SQLOperations) {
 	return ops
 }
 
+// generateAlterForeignKey Generate a MySQL ALTER TABLE statement adding or dropping
+// a FOREIGN KEY constraint from a Table struct
+func generateAlterForeignKey(diff table.Diff) (ops SQLOperations) {
+	var builder StatementBuilder
+	var toFK, fromFK table.ForeignKey
+	var toOk, fromOk bool
+
+	// Obtain Foreign Key Objects
+	diffPair, ok := diff.Value.(table.DiffPair)
+
+	if !ok {
+		toFK, toOk = diff.Value.(table.ForeignKey)
+		fromFK, fromOk = toFK, toOk
+	} else {
+		toFK, toOk = diffPair.To.(table.ForeignKey)
+		fromFK, fromOk = diffPair.From.(table.ForeignKey)
+	}
+
+	if !toOk || !fromOk {
+		util.LogError("Obtaining Foreign Key FAILED")
+		return ops
+	}
+
+	builder.Add("ALTER TABLE")
+	builder.AddQuote(diff.Table)
+	builder.Add("DROP FOREIGN KEY")
+	builder.AddQuote(fromFK.Name)
+
+	removeOp := SQLOperation{
+		Statement: builder.Format(),
+		Op:        table.Del,
+		Name:      fromFK.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	builder.Reset()
+	builder.Add("ALTER TABLE")
+	builder.AddQuote(diff.Table)
+	builder.Add("ADD")
+	builder.Add(toFK.ToSQL())
+
+	addOp := SQLOperation{
+		Statement: builder.Format(),
+		Op:        table.Add,
+		Name:      toFK.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	switch diff.Op {
+
+	case table.Add:
+		ops.Add(addOp)
+
+	case table.Del:
+		ops.Add(removeOp)
+
+	case table.Mod:
+		// Foreign Keys cannot be altered in place, so drop the constraint and re-add
+		ops.Add(removeOp)
+		ops.Add(addOp)
+	}
+
+	return ops
+}
+
+// generateAlterCheckConstraint Generate a MySQL ALTER TABLE statement adding or dropping
+// a CHECK constraint from a Table struct
+func generateAlterCheckConstraint(diff table.Diff) (ops SQLOperations) {
+	var builder StatementBuilder
+	var toChk, fromChk table.CheckConstraint
+	var toOk, fromOk bool
+
+	// Obtain Check Constraint Objects
+	diffPair, ok := diff.Value.(table.DiffPair)
+
+	if !ok {
+		toChk, toOk = diff.Value.(table.CheckConstraint)
+		fromChk, fromOk = toChk, toOk
+	} else {
+		toChk, toOk = diffPair.To.(table.CheckConstraint)
+		fromChk, fromOk = diffPair.From.(table.CheckConstraint)
+	}
+
+	if !toOk || !fromOk {
+		util.LogError("Obtaining Check Constraint FAILED")
+		return ops
+	}
+
+	builder.Add("ALTER TABLE")
+	builder.AddQuote(diff.Table)
+	builder.Add("DROP CHECK")
+	builder.AddQuote(fromChk.Name)
+
+	removeOp := SQLOperation{
+		Statement: builder.Format(),
+		Op:        table.Del,
+		Name:      fromChk.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	builder.Reset()
+	builder.Add("ALTER TABLE")
+	builder.AddQuote(diff.Table)
+	builder.Add("ADD")
+	builder.Add(toChk.ToSQL())
+
+	addOp := SQLOperation{
+		Statement: builder.Format(),
+		Op:        table.Add,
+		Name:      toChk.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	switch diff.Op {
+
+	case table.Add:
+		ops.Add(addOp)
+
+	case table.Del:
+		ops.Add(removeOp)
+
+	case table.Mod:
+		// The expression of a Check Constraint cannot be altered, so drop the constraint and re-add
+		ops.Add(removeOp)
+		ops.Add(addOp)
+	}
+
+	return ops
+}
+
+// generateAlterView Generate MySQL CREATE OR REPLACE VIEW and DROP VIEW statements
+// from a View struct
+func generateAlterView(diff table.Diff) (ops SQLOperations) {
+	var toView, fromView table.View
+	var toOk, fromOk bool
+
+	// Obtain View Objects
+	diffPair, ok := diff.Value.(table.DiffPair)
+
+	if !ok {
+		toView, toOk = diff.Value.(table.View)
+		fromView, fromOk = toView, toOk
+	} else {
+		toView, toOk = diffPair.To.(table.View)
+		fromView, fromOk = diffPair.From.(table.View)
+	}
+
+	if !toOk || !fromOk {
+		util.LogError("Obtaining View FAILED")
+		return ops
+	}
+
+	removeOp := SQLOperation{
+		Statement: fmt.Sprintf("DROP VIEW `%s`;", fromView.Name),
+		Op:        table.Del,
+		Name:      fromView.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	switch diff.Op {
+
+	case table.Add:
+		ops.Add(SQLOperation{
+			Statement: toView.ToSQL() + ";",
+			Op:        table.Add,
+			Name:      toView.Name,
+			Metadata:  diff.Metadata,
+		})
+
+	case table.Del:
+		ops.Add(removeOp)
+
+	case table.Mod:
+		// A renamed View is created under its new name, so the old View is dropped first
+		if fromView.Name != toView.Name {
+			removeOp.Op = table.Mod
+			ops.Add(removeOp)
+		}
+		ops.Add(SQLOperation{
+			Statement: toView.ToSQL() + ";",
+			Op:        table.Mod,
+			Name:      toView.Name,
+			Metadata:  diff.Metadata,
+		})
+	}
+
+	return ops
+}
+
+// generateAlterRoutine Generate MySQL DROP and CREATE statements for a Trigger,
+// Procedure or Function from a Routine struct
+func generateAlterRoutine(diff table.Diff) (ops SQLOperations) {
+	var toRoutine, fromRoutine table.Routine
+	var toOk, fromOk bool
+
+	// Obtain Routine Objects
+	diffPair, ok := diff.Value.(table.DiffPair)
+
+	if !ok {
+		toRoutine, toOk = diff.Value.(table.Routine)
+		fromRoutine, fromOk = toRoutine, toOk
+	} else {
+		toRoutine, toOk = diffPair.To.(table.Routine)
+		fromRoutine, fromOk = diffPair.From.(table.Routine)
+	}
+
+	if !toOk || !fromOk {
+		util.LogError("Obtaining Routine FAILED")
+		return ops
+	}
+
+	removeOp := SQLOperation{
+		Statement: fromRoutine.DropSQL() + ";",
+		Op:        table.Del,
+		Name:      fromRoutine.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	addOp := SQLOperation{
+		Statement: toRoutine.ToSQL() + ";",
+		Op:        table.Add,
+		Name:      toRoutine.Name,
+		Metadata:  diff.Metadata,
+	}
+
+	switch diff.Op {
+
+	case table.Add:
+		ops.Add(addOp)
+
+	case table.Del:
+		ops.Add(removeOp)
+
+	case table.Mod:
+		// Routines cannot be altered in place, so drop the routine and recreate it
+		removeOp.Op = table.Mod
+		addOp.Op = table.Mod
+		ops.Add(removeOp)
+		ops.Add(addOp)
+	}
+
+	return ops
+}
+
+// generateAlterEvent Generate MySQL CREATE, ALTER and DROP EVENT statements from an Event struct
+func generateAlterEvent(diff table.Diff) (ops SQLOperations) {
+	var toEvent, fromEvent table.Event
+	var toOk, fromOk bool
+
+	// Obtain Event Objects
+	diffPair, ok := diff.Value.(table.DiffPair)
+
+	if !ok {
+		toEvent, toOk = diff.Value.(table.Event)
+		fromEvent, fromOk = toEvent, toOk
+	} else {
+		toEvent, toOk = diffPair.To.(table.Event)
+		fromEvent, fromOk = diffPair.From.(table.Event)
+	}
+
+	if !toOk || !fromOk {
+		util.LogError("Obtaining Event FAILED")
+		return ops
+	}
+
+	switch diff.Op {
+
+	case table.Add:
+		ops.Add(SQLOperation{
+			Statement: toEvent.ToSQL() + ";",
+			Op:        table.Add,
+			Name:      toEvent.Name,
+			Metadata:  diff.Metadata,
+		})
+
+	case table.Del:
+		ops.Add(SQLOperation{
+			Statement: fromEvent.DropSQL() + ";",
+			Op:        table.Del,
+			Name:      fromEvent.Name,
+			Metadata:  diff.Metadata,
+		})
+
+	case table.Mod:
+		// ALTER EVENT applies every clause, including any rename, in a single statement
+		ops.Add(SQLOperation{
+			Statement: toEvent.AlterSQL(fromEvent.Name) + ";",
+			Op:        table.Mod,
+			Name:      toEvent.Name,
+			Metadata:  diff.Metadata,
+		})
+	}
+
+	return ops
+}
+
+// generateAlterPartition Generate a MySQL ALTER TABLE statement which changes the
+// partitioning of a table from a Table struct
+func generateAlterPartition(diff table.Diff) (ops SQLOperations) {
+	var builder StatementBuilder
+
+	builder.Add("ALTER TABLE")
+	builder.AddQuote(diff.Table)
+
+	// Partition operations don't have their own Metadata and are applied using the
+	// Table Metadata.  Mod operations must therefore retain the table name.
+	operation := SQLOperation{
+		Op:       diff.Op,
+		Name:     diff.Table,
+		Metadata: diff.Metadata,
+	}
+
+	if diff.Field == "Partitioning" {
+		diffPair, ok := diff.Value.(table.DiffPair)
+		if !ok {
+			util.LogError("Obtaining Partitioning FAILED")
+			return ops
+		}
+
+		toPartitioning, ok := diffPair.To.(table.Partitioning)
+		if !ok {
+			util.LogError("Obtaining Partitioning FAILED")
+			return ops
+		}
+
+		if toPartitioning.IsValid() {
+			builder.Add(toPartitioning.ToSQL())
+		} else {
+			builder.Add("REMOVE PARTITIONING")
+		}
+
+	} else {
+		switch diff.Op {
+
+		case table.Add:
+			partitioning, ok := diff.Value.(table.Partitioning)
+			if !ok {
+				util.LogError("Obtaining Partition FAILED")
+				return ops
+			}
+			builder.Add("ADD PARTITION")
+			builder.Add(partitioning.PartitionsSQL(partitioning.Partitions))
+			operation.Name = diff.Property
+
+		case table.Del:
+			builder.Add("DROP PARTITION")
+			builder.AddQuote(diff.Property)
+			operation.Name = diff.Property
+
+		case table.Mod:
+			diffPair, ok := diff.Value.(table.DiffPair)
+			if !ok {
+				util.LogError("Obtaining Partitions FAILED")
+				return ops
+			}
+
+			toPartitioning, ok := diffPair.To.(table.Partitioning)
+			if !ok {
+				util.LogError("Obtaining Partitions FAILED")
+				return ops
+			}
+			builder.Add("REORGANIZE PARTITION")
+			builder.AddQuote(diff.Property)
+			builder.Add("INTO")
+			builder.Add(toPartitioning.PartitionsSQL(toPartitioning.Partitions))
+		}
+	}
+
+	operation.Statement = builder.Format()
+	ops.Add(operation)
+
+	return ops
+}
+
 // generateAlterTable Generate a MySQL CREATE TABLE, DROP TABLE or ALTER TABLE statement from a
 // Table struct
 func generateAlterTable(diff table.Diff) (ops SQLOperations) {
@@ -452,6 +908,14 @@ func generateAlterTable(diff table.Diff) (ops SQLOperations) {
 				Name:      diff.Table,
 				Metadata:  diff.Metadata,
 			})
+
+		case "Comment":
+			ops.Add(SQLOperation{
+				Statement: fmt.Sprintf("ALTER TABLE `%s` COMMENT=%s;", diff.Table, util.QuoteSQLString(fmt.Sprintf("%s", diff.Value))),
+				Op:        table.Mod,
+				Name:      diff.Table,
+				Metadata:  diff.Metadata,
+			})
 		}
 	}
 
@@ -475,9 +939,38 @@ func GenerateAlters(differences table.Differences) (operations SQLOperations) {
 			// It's an index change.
 			alter = generateAlterIndex(diff)
 
+		} else if diff.Field == "ForeignKeys" {
+			// It's a foreign key change.
+			alter = generateAlterForeignKey(diff)
+
+		} else if diff.Field == "CheckConstraints" {
+			// It's a check constraint change.
+			alter = generateAlterCheckConstraint(diff)
+
+		} else if diff.Field == "View" {
+			// It's a view change.
+			alter = generateAlterView(diff)
+
+		} else if diff.Field == "Routine" {
+			// It's a trigger, procedure or function change.
+			alter = generateAlterRoutine(diff)
+
+		} else if diff.Field == "Event" {
+			// It's an event change.
+			alter = generateAlterEvent(diff)
+
+		} else if diff.Field == "Partitioning" || diff.Field == "Partitions" {
+			// It's a partitioning change.
+			alter = generateAlterPartition(diff)
+
 		} else {
 			alter = generateAlterTable(diff)
 		}
+
+		// Classify the online DDL algorithm of each operation
+		for i := 0; i < len(alter); i++ {
+			alter[i].Algorithm = classifyAlgorithm(diff, alter[i])
+		}
 		operations.Merge(alter)
 	}
 
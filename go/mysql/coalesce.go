@@ -0,0 +1,279 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/table"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// unmergeableClauses ALTER TABLE clauses which either rename the table or which
+// MySQL requires to be the only clause in the statement
+var unmergeableClauses = []string{
+	"RENAME",
+	"PARTITION BY",
+	"REMOVE PARTITIONING",
+	"ADD PARTITION",
+	"DROP PARTITION",
+	"REORGANIZE PARTITION",
+	"COALESCE PARTITION",
+}
+
+// alterClause Extracts the name of the table and the alter specification from an
+// ALTER TABLE, DROP INDEX or CREATE INDEX statement.  Index statements are converted
+// into their equivalent ALTER TABLE specification.  ok is false if the statement
+// can't be merged with other alter specifications.
+func alterClause(statement string) (tableName string, clause string, ok bool) {
+	statement = strings.TrimSuffix(strings.TrimSpace(statement), ";")
+
+	switch {
+	// Format: ALTER TABLE `<TABLE>` <CLAUSE>
+	case strings.HasPrefix(statement, "ALTER TABLE `"):
+		rest := strings.TrimPrefix(statement, "ALTER TABLE `")
+		end := strings.Index(rest, "` ")
+		if end == -1 {
+			return tableName, clause, false
+		}
+		tableName = rest[:end]
+		clause = rest[end+2:]
+
+	// Format: DROP INDEX `<NAME>` ON `<TABLE>`
+	case strings.HasPrefix(statement, "DROP INDEX `"):
+		on := strings.Index(statement, "` ON `")
+		if on == -1 {
+			return tableName, clause, false
+		}
+		tableName = strings.TrimSuffix(statement[on+len("` ON `"):], "`")
+		clause = statement[:on+1]
+
+	// Format: CREATE [<KIND>] INDEX `<NAME>` ON `<TABLE>` <DEFINITION>
+	case strings.HasPrefix(statement, "CREATE "):
+		index := strings.Index(statement, " INDEX `")
+		on := strings.Index(statement, "` ON `")
+		if index == -1 || on < index {
+			return tableName, clause, false
+		}
+
+		kind := strings.TrimSpace(statement[len("CREATE"):index])
+		if strings.Contains(kind, " ") {
+			return tableName, clause, false
+		}
+
+		name := statement[index+len(" INDEX `") : on]
+		rest := statement[on+len("` ON `"):]
+		end := strings.Index(rest, "` ")
+		if end == -1 {
+			return tableName, clause, false
+		}
+		tableName = rest[:end]
+		definition := rest[end+2:]
+
+		if name == "PRIMARY KEY" {
+			clause = fmt.Sprintf("ADD PRIMARY KEY %s", definition)
+		} else if len(kind) > 0 {
+			clause = fmt.Sprintf("ADD %s INDEX `%s` %s", kind, name, definition)
+		} else {
+			clause = fmt.Sprintf("ADD INDEX `%s` %s", name, definition)
+		}
+
+	default:
+		return tableName, clause, false
+	}
+
+	upper := strings.ToUpper(clause)
+	for _, unmergeable := range unmergeableClauses {
+		if strings.HasPrefix(upper, unmergeable) {
+			return tableName, clause, false
+		}
+	}
+
+	return tableName, clause, len(tableName) > 0 && len(clause) > 0
+}
+
+// AlterTableClause Returns the name of the table altered by the statement and its
+// alter specification.  ok is false if the statement doesn't alter a table, or if
+// the statement renames the table or changes its partitioning.
+func AlterTableClause(statement string) (tableName string, clause string, ok bool) {
+	return alterClause(StripAlgorithm(statement))
+}
+
+// StatementTable Returns the name of the table created, altered or dropped by the
+// statement.  Empty if the statement doesn't change a table.
+func StatementTable(statement string) string {
+	trimmed := strings.TrimSpace(statement)
+
+	for _, prefix := range []string{"CREATE TABLE ", "DROP TABLE "} {
+		if strings.HasPrefix(trimmed, prefix) {
+			if names := leadingIdentifiers(trimmed, 1); len(names) > 0 {
+				return names[0]
+			}
+			return ""
+		}
+	}
+
+	tableName, _, _ := AlterTableClause(statement)
+	return tableName
+}
+
+// leadingIdentifiers Returns the first count `quoted` identifiers in the clause
+func leadingIdentifiers(clause string, count int) (names []string) {
+	for len(names) < count {
+		start := strings.Index(clause, "`")
+		if start == -1 {
+			break
+		}
+		end := strings.Index(clause[start+1:], "`")
+		if end == -1 {
+			break
+		}
+		names = append(names, clause[start+1:start+1+end])
+		clause = clause[start+end+2:]
+	}
+	return names
+}
+
+// clauseObjects Returns a key for each of the columns, indexes, constraints and
+// table options changed by an alter specification.  MySQL rejects an ALTER TABLE
+// statement which changes the same object more than once.
+func clauseObjects(clause string) (objects []string) {
+	upper := strings.ToUpper(clause)
+
+	switch {
+	case strings.HasPrefix(upper, "CHANGE COLUMN"):
+		for _, name := range leadingIdentifiers(clause, 2) {
+			objects = append(objects, "column:"+name)
+		}
+	case strings.HasPrefix(upper, "ADD COLUMN"), strings.HasPrefix(upper, "DROP COLUMN"), strings.HasPrefix(upper, "MODIFY COLUMN"):
+		for _, name := range leadingIdentifiers(clause, 1) {
+			objects = append(objects, "column:"+name)
+		}
+	case strings.HasPrefix(upper, "ADD PRIMARY KEY"), strings.HasPrefix(upper, "DROP PRIMARY KEY"):
+		objects = append(objects, "index:PRIMARY")
+	case strings.HasPrefix(upper, "ADD CONSTRAINT"), strings.HasPrefix(upper, "DROP FOREIGN KEY"), strings.HasPrefix(upper, "DROP CHECK"):
+		for _, name := range leadingIdentifiers(clause, 1) {
+			objects = append(objects, "constraint:"+name)
+		}
+	case strings.HasPrefix(upper, "ADD "), strings.HasPrefix(upper, "DROP INDEX"):
+		for _, name := range leadingIdentifiers(clause, 1) {
+			objects = append(objects, "index:"+name)
+		}
+	default:
+		// Table options such as ENGINE=, COMMENT= and DEFAULT CHARACTER SET
+		option := strings.Fields(strings.Replace(upper, "=", " ", 1))[0]
+		objects = append(objects, "option:"+option)
+	}
+
+	return objects
+}
+
+// alterGroup Accumulates the operations and alter specifications which will be
+// merged into a single ALTER TABLE statement
+type alterGroup struct {
+	table      string
+	operations SQLOperations
+	clauses    []string
+	objects    []string
+}
+
+// accepts Returns true if the alter specification can be added to the group
+// without changing the same object twice
+func (g alterGroup) accepts(tableName string, clause string) bool {
+	if g.table != tableName {
+		return false
+	}
+	for _, object := range clauseObjects(clause) {
+		if util.StringInArray(object, g.objects) {
+			return false
+		}
+	}
+	return true
+}
+
+// add Adds an operation and its alter specification to the group
+func (g *alterGroup) add(tableName string, clause string, operation SQLOperation) {
+	g.table = tableName
+	g.operations = append(g.operations, operation)
+	g.clauses = append(g.clauses, clause)
+	g.objects = append(g.objects, clauseObjects(clause)...)
+}
+
+// merged Returns the operations of the group.  A group of multiple operations is
+// merged into a single operation which keeps each of the original operations so
+// that the Metadata of every changed property can be updated.
+func (g alterGroup) merged() (ops SQLOperations) {
+	if len(g.operations) < 2 {
+		return g.operations
+	}
+
+	operation := SQLOperation{
+		Statement:  fmt.Sprintf("ALTER TABLE `%s` %s;", g.table, strings.Join(g.clauses, ", ")),
+		Op:         g.operations[0].Op,
+		Name:       g.table,
+		Metadata:   g.operations[0].Metadata,
+		Safe:       true,
+		Operations: g.operations,
+		Algorithm:  coalescedAlgorithm(g.operations),
+	}
+
+	for _, op := range g.operations {
+		if op.Op != operation.Op {
+			operation.Op = table.Mod
+		}
+		// The merged operation is only safe if none of its operations can lose data
+		if op.Op != table.Add && !op.Safe {
+			operation.Safe = false
+		}
+	}
+
+	ops.Add(operation)
+	return ops
+}
+
+// CoalesceAlters Merges consecutive operations which alter the same table into a
+// single ALTER TABLE statement so that the table is only rebuilt once.  The forward
+// and backward operations are paired by their position, so a pair of operations
+// is only merged if both of them can be merged into the groups of their table.
+func CoalesceAlters(forwards SQLOperations, backwards SQLOperations) (coalescedForwards SQLOperations, coalescedBackwards SQLOperations) {
+
+	if len(forwards) != len(backwards) {
+		util.LogWarnf("Unable to coalesce ALTER statements.  Forward operations: [%d] don't match Backward operations: [%d]", len(forwards), len(backwards))
+		return forwards, backwards
+	}
+
+	var forwardGroup alterGroup
+	var backwardGroup alterGroup
+
+	flush := func() {
+		coalescedForwards = append(coalescedForwards, forwardGroup.merged()...)
+		coalescedBackwards = append(coalescedBackwards, backwardGroup.merged()...)
+		forwardGroup = alterGroup{}
+		backwardGroup = alterGroup{}
+	}
+
+	for i := 0; i < len(forwards); i++ {
+		forwardTable, forwardClause, forwardOk := alterClause(forwards[i].Statement)
+		backwardTable, backwardClause, backwardOk := alterClause(backwards[i].Statement)
+
+		if !forwardOk || !backwardOk || forwardTable != backwardTable {
+			flush()
+			coalescedForwards.Add(forwards[i])
+			coalescedBackwards.Add(backwards[i])
+			continue
+		}
+
+		if !forwardGroup.accepts(forwardTable, forwardClause) || !backwardGroup.accepts(backwardTable, backwardClause) {
+			flush()
+		}
+
+		forwardGroup.add(forwardTable, forwardClause, forwards[i])
+		backwardGroup.add(backwardTable, backwardClause, backwards[i])
+	}
+	flush()
+
+	if len(coalescedForwards) < len(forwards) {
+		util.LogInfof("Coalesced [%d] operations into [%d] statements", len(forwards), len(coalescedForwards))
+	}
+
+	return coalescedForwards, coalescedBackwards
+}
@@ -2,6 +2,13 @@ package mysql
 
 import "time"
 
+// ALTER TABLE Algorithms
+const (
+	AlgorithmInstant = "INSTANT"
+	AlgorithmInplace = "INPLACE"
+	AlgorithmCopy    = "COPY"
+)
+
 // TimeFormat The go time format string for DB times.
 var TimeFormat = `2006-01-02 15:04:05`
 
@@ -140,6 +140,138 @@ var createTableTests = []SQLCTTest{
 		ExpectFail:  false,
 		Description: "Create Table: With Timestamp",
 	},
+
+	{
+		Table: table.Table{
+			Name:    "TestTable",
+			Engine:  "InnoDB",
+			CharSet: "latin1",
+			Columns: []table.Column{
+				table.Column{
+					Name:     "user_id",
+					Type:     "int",
+					Size:     []int{11},
+					Nullable: false,
+					Metadata: metadata.Metadata{
+						Name:   "user_id",
+						Type:   "Column",
+						Exists: true,
+					},
+				},
+			},
+			SecondaryIndexes: []table.Index{
+				table.Index{
+					Name: "idx_user",
+					Columns: []table.IndexColumn{
+						{
+							Name: "user_id",
+						},
+					},
+				},
+			},
+			ForeignKeys: []table.ForeignKey{
+				table.ForeignKey{
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					OnDelete:         "CASCADE",
+				},
+			},
+		},
+		Statement:   "CREATE TABLE `TestTable` (`user_id` int(11) NOT NULL,  KEY `idx_user` (`user_id`),CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE) ENGINE=InnoDB DEFAULT CHARSET=latin1;",
+		ExpectFail:  false,
+		Description: "Create Table: With Foreign Key",
+	},
+
+	{
+		Table: table.Table{
+			Name:    "TestTable",
+			Engine:  "InnoDB",
+			CharSet: "latin1",
+			Comment: "Stores the user's age",
+			Columns: []table.Column{
+				table.Column{
+					Name:     "age",
+					Type:     "int",
+					Size:     []int{11},
+					Nullable: false,
+					Comment:  "Age in years",
+					Metadata: metadata.Metadata{
+						Name:   "age",
+						Type:   "Column",
+						Exists: true,
+					},
+				},
+			},
+		},
+		Statement:   "CREATE TABLE `TestTable` (`age` int(11) NOT NULL COMMENT 'Age in years') ENGINE=InnoDB DEFAULT CHARSET=latin1 COMMENT='Stores the user''s age';",
+		ExpectFail:  false,
+		Description: "Create Table: With Comments",
+	},
+
+	{
+		Table: table.Table{
+			Name:    "TestTable",
+			Engine:  "InnoDB",
+			CharSet: "latin1",
+			Columns: []table.Column{
+				table.Column{
+					Name:     "body",
+					Type:     "text",
+					Nullable: false,
+					Metadata: metadata.Metadata{
+						Name:   "body",
+						Type:   "Column",
+						Exists: true,
+					},
+				},
+			},
+			SecondaryIndexes: []table.Index{
+				table.Index{
+					Name: "idx_body",
+					Kind: table.IndexFullText,
+					Columns: []table.IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+				},
+			},
+		},
+		Statement:   "CREATE TABLE `TestTable` (`body` text NOT NULL, FULLTEXT KEY `idx_body` (`body`)) ENGINE=InnoDB DEFAULT CHARSET=latin1;",
+		ExpectFail:  false,
+		Description: "Create Table: FULLTEXT Index",
+	},
+
+	{
+		Table: table.Table{
+			Name:    "TestTable",
+			Engine:  "InnoDB",
+			CharSet: "latin1",
+			Columns: []table.Column{
+				table.Column{
+					Name:     "price",
+					Type:     "int",
+					Size:     []int{11},
+					Unsigned: true,
+					Nullable: false,
+				},
+				table.Column{
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Unsigned:   true,
+					Nullable:   false,
+					Expression: "`price` * 2",
+					Storage:    table.ColumnStored,
+				},
+			},
+		},
+		Statement:   "CREATE TABLE `TestTable` (`price` int(11) unsigned NOT NULL,`total` int(11) unsigned GENERATED ALWAYS AS (`price` * 2) STORED NOT NULL) ENGINE=InnoDB DEFAULT CHARSET=latin1;",
+		ExpectFail:  false,
+		Description: "Create Table: Generated Column",
+	},
 }
 
 func TestCreateTable(t *testing.T) {
@@ -160,11 +292,19 @@ const (
 	Table = iota
 	Column
 	Index
+	ForeignKey
+	CheckConstraint
+	Partition
+	View
+	Routine
+	Event
 )
 
 type SQLGenTest struct {
 	Diff        table.Diff
 	Statements  []string
+	Safe        bool
+	DataLoss    string
 	ExpectFail  bool
 	Description string
 	TestType    int
@@ -231,6 +371,21 @@ var genTableAlterTests = []SQLGenTest{
 		TestType:    Table,
 	},
 
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Property: "Comment",
+			Value:    "Stores the user's details",
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` COMMENT='Stores the user''s details';",
+		},
+		ExpectFail:  false,
+		Description: "Table Change Comment",
+		TestType:    Table,
+	},
+
 	// Columns
 
 	{
@@ -276,7 +431,7 @@ var genTableAlterTests = []SQLGenTest{
 			Table:    "TestTable",
 			Op:       table.Mod,
 			Field:    "Columns",
-			Property: "Nullable",
+			Property: "Name",
 			Value: table.DiffPair{
 				From: table.Column{
 					ID:   "col1",
@@ -289,10 +444,12 @@ var genTableAlterTests = []SQLGenTest{
 				},
 				To: table.Column{
 					ID:       "col1",
-					Name:     "Add",
+					Name:     "Address",
 					Type:     "varchar",
-					Size:     []int{64},
+					Size:     []int{128},
 					Nullable: true,
+					Default:  "Unknown",
+					Comment:  "Postal Address",
 					Metadata: metadata.Metadata{
 						PropertyID: "col1",
 					},
@@ -303,10 +460,10 @@ var genTableAlterTests = []SQLGenTest{
 			},
 		},
 		Statements: []string{
-			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` varchar(64);",
+			"ALTER TABLE `TestTable` CHANGE COLUMN `Add` `Address` varchar(128) DEFAULT 'Unknown' COMMENT 'Postal Address';",
 		},
 		ExpectFail:  false,
-		Description: "Table Column: Make nullable",
+		Description: "Table Rename and Modify Column",
 		TestType:    Column,
 	},
 
@@ -315,11 +472,11 @@ var genTableAlterTests = []SQLGenTest{
 			Table:    "TestTable",
 			Op:       table.Mod,
 			Field:    "Columns",
-			Property: "AutoInc",
+			Property: "Comment",
 			Value: table.DiffPair{
 				From: table.Column{
 					ID:   "col1",
-					Name: "Add",
+					Name: "Address",
 					Type: "varchar",
 					Size: []int{64},
 					Metadata: metadata.Metadata{
@@ -328,10 +485,10 @@ var genTableAlterTests = []SQLGenTest{
 				},
 				To: table.Column{
 					ID:      "col1",
-					Name:    "Add",
+					Name:    "Address",
 					Type:    "varchar",
 					Size:    []int{64},
-					AutoInc: true,
+					Comment: "Street address",
 					Metadata: metadata.Metadata{
 						PropertyID: "col1",
 					},
@@ -342,10 +499,10 @@ var genTableAlterTests = []SQLGenTest{
 			},
 		},
 		Statements: []string{
-			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` varchar(64) NOT NULL AUTO_INCREMENT;",
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Address` varchar(64) NOT NULL COMMENT 'Street address';",
 		},
 		ExpectFail:  false,
-		Description: "Table Column: Make Auto Increment",
+		Description: "Table Change Column Comment",
 		TestType:    Column,
 	},
 
@@ -354,23 +511,22 @@ var genTableAlterTests = []SQLGenTest{
 			Table:    "TestTable",
 			Op:       table.Mod,
 			Field:    "Columns",
-			Property: "AutoInc",
+			Property: "Values",
 			Value: table.DiffPair{
 				From: table.Column{
-					ID:   "col1",
-					Name: "Add",
-					Type: "varchar",
-					Size: []int{64},
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "medium"},
 					Metadata: metadata.Metadata{
 						PropertyID: "col1",
 					},
 				},
 				To: table.Column{
-					ID:      "col1",
-					Name:    "Add",
-					Type:    "varchar",
-					Size:    []int{64},
-					Default: "hello",
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "medium", "large"},
 					Metadata: metadata.Metadata{
 						PropertyID: "col1",
 					},
@@ -381,10 +537,11 @@ var genTableAlterTests = []SQLGenTest{
 			},
 		},
 		Statements: []string{
-			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` varchar(64) NOT NULL DEFAULT 'hello';",
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Size` enum('small','medium','large') NOT NULL;",
 		},
+		Safe:       true,
 		ExpectFail:  false,
-		Description: "Table Column: Add DEFAULT value",
+		Description: "Table Column: Append ENUM member",
 		TestType:    Column,
 	},
 
@@ -393,21 +550,22 @@ var genTableAlterTests = []SQLGenTest{
 			Table:    "TestTable",
 			Op:       table.Mod,
 			Field:    "Columns",
-			Property: "Nullable",
+			Property: "Values",
 			Value: table.DiffPair{
 				From: table.Column{
-					ID:   "col1",
-					Name: "Add",
-					Type: "varchar",
-					Size: []int{64},
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "medium", "large"},
 					Metadata: metadata.Metadata{
 						PropertyID: "col1",
 					},
 				},
 				To: table.Column{
-					ID:   "col1",
-					Name: "Add",
-					Type: "text",
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"large", "medium", "small"},
 					Metadata: metadata.Metadata{
 						PropertyID: "col1",
 					},
@@ -418,165 +576,1270 @@ var genTableAlterTests = []SQLGenTest{
 			},
 		},
 		Statements: []string{
-			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` text NOT NULL;",
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Size` enum('large','medium','small') NOT NULL;",
 		},
 		ExpectFail:  false,
-		Description: "Table Column: Change type to text",
+		Description: "Table Column: Reorder ENUM members",
 		TestType:    Column,
 	},
 
-	// Indexes
 	{
 		Diff: table.Diff{
 			Table:    "TestTable",
-			Field:    "SecondaryIndexes",
 			Op:       table.Mod,
-			Property: "Name",
+			Field:    "Columns",
+			Property: "Values",
 			Value: table.DiffPair{
-				From: table.Index{
-					ID:   "sc1",
-					Name: "idx_test",
-					Columns: []table.IndexColumn{
-						{
-							Name: "address",
-						},
-					},
-					IsPrimary: false,
-					IsUnique:  false,
+				From: table.Column{
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "medium", "large"},
 					Metadata: metadata.Metadata{
-						PropertyID: "sc1",
+						PropertyID: "col1",
 					},
 				},
-				To: table.Index{
-					ID:   "sc1",
-					Name: "idx_address",
-					Columns: []table.IndexColumn{
-						{
-							Name: "address",
-						},
-					},
-					IsPrimary: false,
-					IsUnique:  false,
+				To: table.Column{
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "large"},
 					Metadata: metadata.Metadata{
-						PropertyID: "sc1",
+						PropertyID: "col1",
 					},
 				},
 			},
 			Metadata: metadata.Metadata{
-				PropertyID: "sc1",
+				PropertyID: "col1",
 			},
 		},
 		Statements: []string{
-			"ALTER TABLE `TestTable` RENAME idx_test idx_address;",
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Size` enum('small','large') NOT NULL;",
 		},
-		ExpectFail: false,
-		TestType:   Index,
+		DataLoss:    "SELECT COUNT(*) FROM `TestTable` WHERE (`Size` IN ('medium'))",
+		ExpectFail:  false,
+		Description: "Table Column: Remove ENUM member",
+		TestType:    Column,
 	},
 
 	{
 		Diff: table.Diff{
 			Table:    "TestTable",
-			Field:    "SecondaryIndexes",
 			Op:       table.Mod,
-			Property: "Columns",
+			Field:    "Columns",
+			Property: "Size",
 			Value: table.DiffPair{
-				From: table.Index{
-					ID:   "sc1",
-					Name: "idx_test",
-					Columns: []table.IndexColumn{
-						{
-							Name: "address",
-						},
-					},
-					IsPrimary: false,
-					IsUnique:  false,
+				From: table.Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{255},
 					Metadata: metadata.Metadata{
-						PropertyID: "sc1",
+						PropertyID: "col1",
 					},
 				},
-				To: table.Index{
-					ID:   "sc1",
-					Name: "idx_test",
-					Columns: []table.IndexColumn{
-						{
-							Name: "address",
-						},
-						{
-							Name: "add",
-						},
-					},
-					IsPrimary: false,
-					IsUnique:  false,
+				To: table.Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{32},
 					Metadata: metadata.Metadata{
-						PropertyID: "sc1",
+						PropertyID: "col1",
 					},
 				},
 			},
 			Metadata: metadata.Metadata{
-				PropertyID: "sc1",
+				PropertyID: "col1",
 			},
 		},
 		Statements: []string{
-			"DROP INDEX `idx_test` ON `TestTable`;",
-			"CREATE INDEX `idx_test` ON `TestTable` (`address`,`add`);",
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Address` varchar(32) NOT NULL;",
 		},
+		DataLoss:    "SELECT COUNT(*) FROM `TestTable` WHERE (CHAR_LENGTH(`Address`) > 32)",
 		ExpectFail:  false,
-		Description: "Table Index: Add Column",
-		TestType:    Index,
+		Description: "Table Column: Shrink VARCHAR",
+		TestType:    Column,
 	},
 
-
-
 	{
 		Diff: table.Diff{
 			Table:    "TestTable",
-			Field:    "PrimaryIndex",
 			Op:       table.Mod,
-			Property: "Columns",
+			Field:    "Columns",
+			Property: "Type",
 			Value: table.DiffPair{
-				From: table.Index{
-					ID:   "PrimaryIndex",
-					Name: "PrimaryIndex",
-					Columns: []table.IndexColumn{
-						{
-							Name: "address",
-						},
+				From: table.Column{
+					ID:       "col1",
+					Name:     "Count",
+					Type:     "int",
+					Size:     []int{11},
+					Nullable: true,
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
 					},
-					IsPrimary: true,
-					IsUnique:  false,
+				},
+				To: table.Column{
+					ID:       "col1",
+					Name:     "Count",
+					Type:     "tinyint",
+					Size:     []int{3},
+					Unsigned: true,
 					Metadata: metadata.Metadata{
-						PropertyID: "PrimaryIndex",
+						PropertyID: "col1",
 					},
 				},
-				To: table.Index{
-					ID:   "PrimaryIndex",
-					Name: "PrimaryIndex",
-					Columns: []table.IndexColumn{
-						{
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Count` tinyint(3) unsigned NOT NULL;",
+		},
+		DataLoss:    "SELECT COUNT(*) FROM `TestTable` WHERE (`Count` < 0) OR (`Count` > 255) OR (`Count` IS NULL)",
+		ExpectFail:  false,
+		Description: "Table Column: Narrow INT to unsigned TINYINT",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "Size",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:   "col1",
+					Name: "Price",
+					Type: "decimal",
+					Size: []int{10, 4},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				To: table.Column{
+					ID:   "col1",
+					Name: "Price",
+					Type: "decimal",
+					Size: []int{12, 2},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Price` decimal(12,2) NOT NULL;",
+		},
+		DataLoss:    "SELECT COUNT(*) FROM `TestTable` WHERE (`Price` <> ROUND(`Price`, 2))",
+		ExpectFail:  false,
+		Description: "Table Column: Reduce DECIMAL scale",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "CharSet",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				To: table.Column{
+					ID:        "col1",
+					Name:      "Address",
+					Type:      "varchar",
+					Size:      []int{64},
+					CharSet:   "utf8mb4",
+					Collation: "utf8mb4_bin",
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Address` varchar(64) CHARACTER SET utf8mb4 NOT NULL COLLATE utf8mb4_bin;",
+		},
+		ExpectFail:  false,
+		Description: "Table Change Column CharSet",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Add,
+			Field:    "Columns",
+			Property: "total",
+			Value: table.Column{
+				ID:         "col2",
+				Name:       "total",
+				Type:       "int",
+				Size:       []int{11},
+				Nullable:   true,
+				Expression: "`price` * `quantity`",
+				Storage:    table.ColumnStored,
+				Metadata: metadata.Metadata{
+					PropertyID: "col2",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col2",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` ADD COLUMN `total` int(11) GENERATED ALWAYS AS (`price` * `quantity`) STORED;",
+		},
+		ExpectFail:  false,
+		Description: "Table Add Generated Column",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "Storage",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:         "col2",
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Nullable:   true,
+					Expression: "`price` * `quantity`",
+					Storage:    table.ColumnVirtual,
+					Metadata: metadata.Metadata{
+						PropertyID: "col2",
+					},
+				},
+				To: table.Column{
+					ID:         "col2",
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Nullable:   true,
+					Expression: "`price` * `quantity`",
+					Storage:    table.ColumnStored,
+					Metadata: metadata.Metadata{
+						PropertyID: "col2",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col2",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP COLUMN `total`;",
+			"ALTER TABLE `TestTable` ADD COLUMN `total` int(11) GENERATED ALWAYS AS (`price` * `quantity`) STORED;",
+		},
+		ExpectFail:  false,
+		Description: "Table Change Generated Column Storage",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "Nullable",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:   "col1",
+					Name: "Add",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				To: table.Column{
+					ID:       "col1",
+					Name:     "Add",
+					Type:     "varchar",
+					Size:     []int{64},
+					Nullable: true,
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` varchar(64);",
+		},
+		ExpectFail:  false,
+		Description: "Table Column: Make nullable",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "AutoInc",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:   "col1",
+					Name: "Add",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				To: table.Column{
+					ID:      "col1",
+					Name:    "Add",
+					Type:    "varchar",
+					Size:    []int{64},
+					AutoInc: true,
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` varchar(64) NOT NULL AUTO_INCREMENT;",
+		},
+		ExpectFail:  false,
+		Description: "Table Column: Make Auto Increment",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "AutoInc",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:   "col1",
+					Name: "Add",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				To: table.Column{
+					ID:      "col1",
+					Name:    "Add",
+					Type:    "varchar",
+					Size:    []int{64},
+					Default: "hello",
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` varchar(64) NOT NULL DEFAULT 'hello';",
+		},
+		ExpectFail:  false,
+		Description: "Table Column: Add DEFAULT value",
+		TestType:    Column,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Op:       table.Mod,
+			Field:    "Columns",
+			Property: "Nullable",
+			Value: table.DiffPair{
+				From: table.Column{
+					ID:   "col1",
+					Name: "Add",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				To: table.Column{
+					ID:   "col1",
+					Name: "Add",
+					Type: "text",
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "col1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` MODIFY COLUMN `Add` text NOT NULL;",
+		},
+		ExpectFail:  false,
+		Description: "Table Column: Change type to text",
+		TestType:    Column,
+	},
+
+	// Indexes
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "SecondaryIndexes",
+			Op:       table.Mod,
+			Property: "Name",
+			Value: table.DiffPair{
+				From: table.Index{
+					ID:   "sc1",
+					Name: "idx_test",
+					Columns: []table.IndexColumn{
+						{
+							Name: "address",
+						},
+					},
+					IsPrimary: false,
+					IsUnique:  false,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				To: table.Index{
+					ID:   "sc1",
+					Name: "idx_address",
+					Columns: []table.IndexColumn{
+						{
+							Name: "address",
+						},
+					},
+					IsPrimary: false,
+					IsUnique:  false,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "sc1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` RENAME idx_test idx_address;",
+		},
+		ExpectFail: false,
+		TestType:   Index,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "SecondaryIndexes",
+			Op:       table.Mod,
+			Property: "Columns",
+			Value: table.DiffPair{
+				From: table.Index{
+					ID:   "sc1",
+					Name: "idx_test",
+					Columns: []table.IndexColumn{
+						{
+							Name: "address",
+						},
+					},
+					IsPrimary: false,
+					IsUnique:  false,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				To: table.Index{
+					ID:   "sc1",
+					Name: "idx_test",
+					Columns: []table.IndexColumn{
+						{
+							Name: "address",
+						},
+						{
+							Name: "add",
+						},
+					},
+					IsPrimary: false,
+					IsUnique:  false,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "sc1",
+			},
+		},
+		Statements: []string{
+			"DROP INDEX `idx_test` ON `TestTable`;",
+			"CREATE INDEX `idx_test` ON `TestTable` (`address`,`add`);",
+		},
+		ExpectFail:  false,
+		Description: "Table Index: Add Column",
+		TestType:    Index,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "SecondaryIndexes",
+			Op:       table.Add,
+			Property: "idx_body",
+			Value: table.Index{
+				ID:   "sc2",
+				Name: "idx_body",
+				Columns: []table.IndexColumn{
+					{
+						Name: "body",
+					},
+				},
+				Kind:   table.IndexFullText,
+				Parser: "ngram",
+				Metadata: metadata.Metadata{
+					PropertyID: "sc2",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "sc2",
+			},
+		},
+		Statements: []string{
+			"CREATE FULLTEXT INDEX `idx_body` ON `TestTable` (`body`) WITH PARSER `ngram`;",
+		},
+		ExpectFail:  false,
+		Description: "Table Index: Add FULLTEXT Index",
+		TestType:    Index,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "SecondaryIndexes",
+			Op:       table.Add,
+			Property: "idx_name",
+			Value: table.Index{
+				ID:   "sc3",
+				Name: "idx_name",
+				Columns: []table.IndexColumn{
+					{
+						Name: "name",
+					},
+				},
+				IsUnique: true,
+				Using:    "HASH",
+				Metadata: metadata.Metadata{
+					PropertyID: "sc3",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "sc3",
+			},
+		},
+		Statements: []string{
+			"CREATE UNIQUE INDEX `idx_name` ON `TestTable` (`name`) USING HASH;",
+		},
+		ExpectFail:  false,
+		Description: "Table Index: Add UNIQUE Index USING HASH",
+		TestType:    Index,
+	},
+
+
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "PrimaryIndex",
+			Op:       table.Mod,
+			Property: "Columns",
+			Value: table.DiffPair{
+				From: table.Index{
+					ID:   "PrimaryIndex",
+					Name: "PrimaryIndex",
+					Columns: []table.IndexColumn{
+						{
+							Name: "address",
+						},
+					},
+					IsPrimary: true,
+					IsUnique:  false,
+					Metadata: metadata.Metadata{
+						PropertyID: "PrimaryIndex",
+					},
+				},
+				To: table.Index{
+					ID:   "PrimaryIndex",
+					Name: "PrimaryIndex",
+					Columns: []table.IndexColumn{
+						{
 							Name: "address",
 						},
 						{
 							Name: "add",
 						},
 					},
-					IsPrimary: true,
-					IsUnique:  false,
+					IsPrimary: true,
+					IsUnique:  false,
+					Metadata: metadata.Metadata{
+						PropertyID: "PrimaryIndex",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "PrimaryIndex",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP PRIMARY KEY;",
+			"CREATE INDEX `PRIMARY KEY` ON `TestTable` (`address`,`add`);",
+		},
+		ExpectFail:  false,
+		Description: "Table PrimaryKey: Alter Primary Key",
+		TestType:    Index,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "ForeignKeys",
+			Op:       table.Add,
+			Property: "fk_user",
+			Value: table.ForeignKey{
+				ID:               "fk1",
+				Name:             "fk_user",
+				Columns:          []string{"user_id"},
+				ReferenceTable:   "user",
+				ReferenceColumns: []string{"id"},
+				OnDelete:         "CASCADE",
+				Metadata: metadata.Metadata{
+					PropertyID: "fk1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "fk1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` ADD CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;",
+		},
+		ExpectFail:  false,
+		Description: "Table Foreign Key: Add Foreign Key",
+		TestType:    ForeignKey,
+	},
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "ForeignKeys",
+			Op:       table.Del,
+			Property: "fk_user",
+			Value: table.ForeignKey{
+				ID:               "fk1",
+				Name:             "fk_user",
+				Columns:          []string{"user_id"},
+				ReferenceTable:   "user",
+				ReferenceColumns: []string{"id"},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "fk1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP FOREIGN KEY `fk_user`;",
+		},
+		ExpectFail:  false,
+		Description: "Table Foreign Key: Drop Foreign Key",
+		TestType:    ForeignKey,
+	},
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "ForeignKeys",
+			Op:       table.Mod,
+			Property: "OnDelete",
+			Value: table.DiffPair{
+				From: table.ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+				To: table.ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					OnDelete:         "CASCADE",
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "fk1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP FOREIGN KEY `fk_user`;",
+			"ALTER TABLE `TestTable` ADD CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;",
+		},
+		ExpectFail:  false,
+		Description: "Table Foreign Key: Alter Foreign Key",
+		TestType:    ForeignKey,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "CheckConstraints",
+			Op:       table.Add,
+			Property: "chk_price",
+			Value: table.CheckConstraint{
+				ID:         "chk1",
+				Name:       "chk_price",
+				Expression: "`price` > 0",
+				Metadata: metadata.Metadata{
+					PropertyID: "chk1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "chk1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` ADD CONSTRAINT `chk_price` CHECK (`price` > 0);",
+		},
+		ExpectFail:  false,
+		Description: "Table Check Constraint: Add Check Constraint",
+		TestType:    CheckConstraint,
+	},
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "CheckConstraints",
+			Op:       table.Del,
+			Property: "chk_price",
+			Value: table.CheckConstraint{
+				ID:         "chk1",
+				Name:       "chk_price",
+				Expression: "`price` > 0",
+				Metadata: metadata.Metadata{
+					PropertyID: "chk1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "chk1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP CHECK `chk_price`;",
+		},
+		ExpectFail:  false,
+		Description: "Table Check Constraint: Drop Check Constraint",
+		TestType:    CheckConstraint,
+	},
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "CheckConstraints",
+			Op:       table.Mod,
+			Property: "Expression",
+			Value: table.DiffPair{
+				From: table.CheckConstraint{
+					ID:         "chk1",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+				To: table.CheckConstraint{
+					ID:          "chk1",
+					Name:        "chk_price",
+					Expression:  "`price` >= 0",
+					NotEnforced: true,
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "chk1",
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP CHECK `chk_price`;",
+			"ALTER TABLE `TestTable` ADD CONSTRAINT `chk_price` CHECK (`price` >= 0) /*!80016 NOT ENFORCED */;",
+		},
+		ExpectFail:  false,
+		Description: "Table Check Constraint: Alter Check Constraint",
+		TestType:    CheckConstraint,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "Partitions",
+			Op:       table.Add,
+			Property: "p2019",
+			Value: table.Partitioning{
+				Type:       table.PartitionRange,
+				Expression: "to_days(`created`)",
+				Partitions: []table.Partition{
+					{
+						Name:   "p2019",
+						Values: "(737425)",
+					},
+				},
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` ADD PARTITION (PARTITION `p2019` VALUES LESS THAN (737425));",
+		},
+		ExpectFail:  false,
+		Description: "Table Partition: Add Partition",
+		TestType:    Partition,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "Partitions",
+			Op:       table.Del,
+			Property: "p2016",
+			Value: table.Partitioning{
+				Type:       table.PartitionRange,
+				Expression: "to_days(`created`)",
+				Partitions: []table.Partition{
+					{
+						Name:   "p2016",
+						Values: "(736330)",
+					},
+				},
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` DROP PARTITION `p2016`;",
+		},
+		ExpectFail:  false,
+		Description: "Table Partition: Drop Partition",
+		TestType:    Partition,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "Partitions",
+			Op:       table.Mod,
+			Property: "pmax",
+			Value: table.DiffPair{
+				From: table.Partitioning{
+					Type:       table.PartitionRange,
+					Expression: "to_days(`created`)",
+					Partitions: []table.Partition{
+						{
+							Name:   "pmax",
+							Values: "MAXVALUE",
+						},
+					},
+				},
+				To: table.Partitioning{
+					Type:       table.PartitionRange,
+					Expression: "to_days(`created`)",
+					Partitions: []table.Partition{
+						{
+							Name:   "p2018",
+							Values: "(737060)",
+						},
+						{
+							Name:   "pmax",
+							Values: "MAXVALUE",
+						},
+					},
+				},
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` REORGANIZE PARTITION `pmax` INTO (PARTITION `p2018` VALUES LESS THAN (737060), PARTITION `pmax` VALUES LESS THAN MAXVALUE);",
+		},
+		ExpectFail:  false,
+		Description: "Table Partition: Reorganize Partition",
+		TestType:    Partition,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "Partitioning",
+			Op:       table.Mod,
+			Property: "Partitioning",
+			Value: table.DiffPair{
+				From: table.Partitioning{},
+				To: table.Partitioning{
+					Type:       table.PartitionHash,
+					Expression: "`id`",
+					Count:      8,
+				},
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` PARTITION BY HASH (`id`) PARTITIONS 8;",
+		},
+		ExpectFail:  false,
+		Description: "Table Partition: Partition Table",
+		TestType:    Partition,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "TestTable",
+			Field:    "Partitioning",
+			Op:       table.Mod,
+			Property: "Partitioning",
+			Value: table.DiffPair{
+				From: table.Partitioning{
+					Type:       table.PartitionHash,
+					Expression: "`id`",
+					Count:      8,
+				},
+				To: table.Partitioning{},
+			},
+		},
+		Statements: []string{
+			"ALTER TABLE `TestTable` REMOVE PARTITIONING;",
+		},
+		ExpectFail:  false,
+		Description: "Table Partition: Remove Partitioning",
+		TestType:    Partition,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "active_dogs",
+			Field:    "View",
+			Op:       table.Add,
+			Property: "active_dogs",
+			Value: table.View{
+				ID:         "view1",
+				Name:       "active_dogs",
+				Definition: "select `dogs`.`id` AS `id` from `dogs`",
+				Algorithm:  "UNDEFINED",
+				Security:   "DEFINER",
+				Metadata: metadata.Metadata{
+					PropertyID: "view1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "view1",
+			},
+		},
+		Statements: []string{
+			"CREATE OR REPLACE ALGORITHM=UNDEFINED SQL SECURITY DEFINER VIEW `active_dogs` AS select `dogs`.`id` AS `id` from `dogs`;",
+		},
+		ExpectFail:  false,
+		Description: "View: Create View",
+		TestType:    View,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "active_dogs",
+			Field:    "View",
+			Op:       table.Del,
+			Property: "active_dogs",
+			Value: table.View{
+				ID:         "view1",
+				Name:       "active_dogs",
+				Definition: "select `dogs`.`id` AS `id` from `dogs`",
+				Algorithm:  "UNDEFINED",
+				Security:   "DEFINER",
+				Metadata: metadata.Metadata{
+					PropertyID: "view1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "view1",
+			},
+		},
+		Statements: []string{
+			"DROP VIEW `active_dogs`;",
+		},
+		ExpectFail:  false,
+		Description: "View: Drop View",
+		TestType:    View,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "current_dogs",
+			Field:    "View",
+			Op:       table.Mod,
+			Property: "Name",
+			Value: table.DiffPair{
+				From: table.View{
+					ID:         "view1",
+					Name:       "active_dogs",
+					Definition: "select `dogs`.`id` AS `id` from `dogs`",
+					Algorithm:  "UNDEFINED",
+					Security:   "DEFINER",
+					Metadata: metadata.Metadata{
+						PropertyID: "view1",
+					},
+				},
+				To: table.View{
+					ID:         "view1",
+					Name:       "current_dogs",
+					Definition: "select `dogs`.`id` AS `id` from `dogs`",
+					Algorithm:  "UNDEFINED",
+					Security:   "DEFINER",
+					CheckOption: "LOCAL",
+					Metadata: metadata.Metadata{
+						PropertyID: "view1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "view1",
+			},
+		},
+		Statements: []string{
+			"DROP VIEW `active_dogs`;",
+			"CREATE OR REPLACE ALGORITHM=UNDEFINED SQL SECURITY DEFINER VIEW `current_dogs` AS select `dogs`.`id` AS `id` from `dogs` WITH LOCAL CHECK OPTION;",
+		},
+		ExpectFail:  false,
+		Description: "View: Rename View",
+		TestType:    View,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "dogs_audit",
+			Field:    "Routine",
+			Op:       table.Add,
+			Property: "dogs_audit",
+			Value: table.Routine{
+				ID:         "trigger1",
+				Name:       "dogs_audit",
+				Type:       "TRIGGER",
+				Definition: "CREATE TRIGGER `dogs_audit` BEFORE UPDATE ON `dogs` FOR EACH ROW SET NEW.updated = NOW()",
+				Metadata: metadata.Metadata{
+					PropertyID: "trigger1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "trigger1",
+			},
+		},
+		Statements: []string{
+			"CREATE TRIGGER `dogs_audit` BEFORE UPDATE ON `dogs` FOR EACH ROW SET NEW.updated = NOW();",
+		},
+		ExpectFail:  false,
+		Description: "Routine: Create Trigger",
+		TestType:    Routine,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "dog_age",
+			Field:    "Routine",
+			Op:       table.Del,
+			Property: "dog_age",
+			Value: table.Routine{
+				ID:         "function1",
+				Name:       "dog_age",
+				Type:       "FUNCTION",
+				Definition: "CREATE FUNCTION `dog_age`(born DATE) RETURNS int(11) DETERMINISTIC RETURN TIMESTAMPDIFF(YEAR, born, CURDATE())",
+				Metadata: metadata.Metadata{
+					PropertyID: "function1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "function1",
+			},
+		},
+		Statements: []string{
+			"DROP FUNCTION IF EXISTS `dog_age`;",
+		},
+		ExpectFail:  false,
+		Description: "Routine: Drop Function",
+		TestType:    Routine,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "count_dogs",
+			Field:    "Routine",
+			Op:       table.Mod,
+			Property: "Definition",
+			Value: table.DiffPair{
+				From: table.Routine{
+					ID:         "procedure1",
+					Name:       "count_dogs",
+					Type:       "PROCEDURE",
+					Definition: "CREATE PROCEDURE `count_dogs`() SELECT COUNT(*) FROM `dogs`",
+					Metadata: metadata.Metadata{
+						PropertyID: "procedure1",
+					},
+				},
+				To: table.Routine{
+					ID:         "procedure1",
+					Name:       "count_dogs",
+					Type:       "PROCEDURE",
+					Definition: "CREATE PROCEDURE `count_dogs`() SELECT COUNT(*) FROM `dogs` WHERE `active` = 1",
 					Metadata: metadata.Metadata{
-						PropertyID: "PrimaryIndex",
+						PropertyID: "procedure1",
 					},
 				},
 			},
 			Metadata: metadata.Metadata{
-				PropertyID: "PrimaryIndex",
+				PropertyID: "procedure1",
 			},
 		},
 		Statements: []string{
-			"ALTER TABLE `TestTable` DROP PRIMARY KEY;",
-			"CREATE INDEX `PRIMARY KEY` ON `TestTable` (`address`,`add`);",
+			"DROP PROCEDURE IF EXISTS `count_dogs`;",
+			"CREATE PROCEDURE `count_dogs`() SELECT COUNT(*) FROM `dogs` WHERE `active` = 1;",
 		},
 		ExpectFail:  false,
-		Description: "Table PrimaryKey: Alter Primary Key",
-		TestType:    Index,
+		Description: "Routine: Modify Procedure",
+		TestType:    Routine,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "animals_cleanup",
+			Field:    "Event",
+			Op:       table.Add,
+			Property: "animals_cleanup",
+			Value: table.Event{
+				ID:           "event1",
+				Name:         "animals_cleanup",
+				Schedule:     "EVERY 1 DAY",
+				Status:       "ENABLE",
+				OnCompletion: "NOT PRESERVE",
+				Comment:      "Nightly cleanup",
+				Body:         "DELETE FROM `dogs` WHERE `active` = 0",
+				Metadata: metadata.Metadata{
+					PropertyID: "event1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "event1",
+			},
+		},
+		Statements: []string{
+			"CREATE EVENT `animals_cleanup` ON SCHEDULE EVERY 1 DAY ON COMPLETION NOT PRESERVE ENABLE COMMENT 'Nightly cleanup' DO DELETE FROM `dogs` WHERE `active` = 0;",
+		},
+		ExpectFail:  false,
+		Description: "Event: Create Event",
+		TestType:    Event,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "animals_cleanup",
+			Field:    "Event",
+			Op:       table.Del,
+			Property: "animals_cleanup",
+			Value: table.Event{
+				ID:           "event1",
+				Name:         "animals_cleanup",
+				Schedule:     "EVERY 1 DAY",
+				Status:       "ENABLE",
+				OnCompletion: "NOT PRESERVE",
+				Body:         "DELETE FROM `dogs` WHERE `active` = 0",
+				Metadata: metadata.Metadata{
+					PropertyID: "event1",
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "event1",
+			},
+		},
+		Statements: []string{
+			"DROP EVENT `animals_cleanup`;",
+		},
+		ExpectFail:  false,
+		Description: "Event: Drop Event",
+		TestType:    Event,
+	},
+
+	{
+		Diff: table.Diff{
+			Table:    "animals_purge",
+			Field:    "Event",
+			Op:       table.Mod,
+			Property: "Name",
+			Value: table.DiffPair{
+				From: table.Event{
+					ID:           "event1",
+					Name:         "animals_cleanup",
+					Schedule:     "EVERY 1 DAY",
+					Status:       "ENABLE",
+					OnCompletion: "NOT PRESERVE",
+					Body:         "DELETE FROM `dogs` WHERE `active` = 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "event1",
+					},
+				},
+				To: table.Event{
+					ID:           "event1",
+					Name:         "animals_purge",
+					Schedule:     "EVERY 1 WEEK",
+					Status:       "DISABLE",
+					OnCompletion: "NOT PRESERVE",
+					Body:         "DELETE FROM `dogs` WHERE `active` = 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "event1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "event1",
+			},
+		},
+		Statements: []string{
+			"ALTER EVENT `animals_cleanup` ON SCHEDULE EVERY 1 WEEK ON COMPLETION NOT PRESERVE RENAME TO `animals_purge` DISABLE DO DELETE FROM `dogs` WHERE `active` = 0;",
+		},
+		ExpectFail:  false,
+		Description: "Event: Rename and Alter Event",
+		TestType:    Event,
 	},
 }
 
@@ -595,6 +1858,18 @@ func TestGenerateAlters(t *testing.T) {
 			results = generateAlterColumn(test.Diff)
 		case Index:
 			results = generateAlterIndex(test.Diff)
+		case ForeignKey:
+			results = generateAlterForeignKey(test.Diff)
+		case CheckConstraint:
+			results = generateAlterCheckConstraint(test.Diff)
+		case Partition:
+			results = generateAlterPartition(test.Diff)
+		case View:
+			results = generateAlterView(test.Diff)
+		case Routine:
+			results = generateAlterRoutine(test.Diff)
+		case Event:
+			results = generateAlterEvent(test.Diff)
 
 		}
 
@@ -606,6 +1881,12 @@ func TestGenerateAlters(t *testing.T) {
 				util.LogWarnf("%s FAILED.", test.Description)
 				util.DebugDiffString(test.Statements[i], results[i].Statement)
 			}
+			if results[i].Safe != test.Safe {
+				t.Errorf("%s FAILED. Expected Safe: %t", test.Description, test.Safe)
+			}
+			if results[i].DataLoss != test.DataLoss {
+				t.Errorf("%s FAILED. Expected DataLoss: [%s] Got: [%s]", test.Description, test.DataLoss, results[i].DataLoss)
+			}
 		}
 
 		if !pass {
@@ -616,3 +1897,429 @@ func TestGenerateAlters(t *testing.T) {
 	mgmtDb.ExpectionsMet("TestGenerateAlters", t)
 
 }
+
+func TestGenerateAltersModifiedForeignKey(t *testing.T) {
+	testName := "TestGenerateAltersModifiedForeignKey"
+
+	foreignKey := func(onDelete string, onUpdate string) table.Table {
+		return table.Table{
+			Name: "TestTable",
+			ForeignKeys: []table.ForeignKey{
+				{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					OnDelete:         onDelete,
+					OnUpdate:         onUpdate,
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "tbl1",
+			},
+		}
+	}
+
+	// Both referential actions of the Foreign Key change
+	diffs, err := table.DiffTables([]table.Table{foreignKey("CASCADE", "SET NULL")}, []table.Table{foreignKey("", "")}, true, true)
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+		return
+	}
+
+	// The Foreign Key is only dropped and recreated once
+	expected := []string{
+		"ALTER TABLE `TestTable` DROP FOREIGN KEY `fk_user`;",
+		"ALTER TABLE `TestTable` ADD CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE ON UPDATE SET NULL;",
+	}
+
+	results := GenerateAlters(diffs)
+
+	if len(results) != len(expected) {
+		t.Errorf("%s FAILED. Expected: [%d] statements Generated: [%d]", testName, len(expected), len(results))
+		return
+	}
+	for i := range expected {
+		if results[i].Statement != expected[i] {
+			t.Errorf("%s FAILED.", testName)
+			util.DebugDiffString(expected[i], results[i].Statement)
+		}
+	}
+}
+
+func TestGenerateAltersModifiedCheckConstraint(t *testing.T) {
+	testName := "TestGenerateAltersModifiedCheckConstraint"
+
+	checkConstraint := func(expression string, notEnforced bool) table.Table {
+		return table.Table{
+			Name: "TestTable",
+			CheckConstraints: []table.CheckConstraint{
+				{
+					ID:          "chk1",
+					Name:        "chk_price",
+					Expression:  expression,
+					NotEnforced: notEnforced,
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "tbl1",
+			},
+		}
+	}
+
+	// Both the expression and the enforcement of the Check Constraint change
+	diffs, err := table.DiffTables([]table.Table{checkConstraint("`price` >= 0", true)}, []table.Table{checkConstraint("`price` > 0", false)}, true, true)
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+		return
+	}
+
+	// The Check Constraint is only dropped and recreated once
+	expected := []string{
+		"ALTER TABLE `TestTable` DROP CHECK `chk_price`;",
+		"ALTER TABLE `TestTable` ADD CONSTRAINT `chk_price` CHECK (`price` >= 0) /*!80016 NOT ENFORCED */;",
+	}
+
+	results := GenerateAlters(diffs)
+
+	if len(results) != len(expected) {
+		t.Errorf("%s FAILED. Expected: [%d] statements Generated: [%d]", testName, len(expected), len(results))
+		return
+	}
+	for i := range expected {
+		if results[i].Statement != expected[i] {
+			t.Errorf("%s FAILED.", testName)
+			util.DebugDiffString(expected[i], results[i].Statement)
+		}
+	}
+}
+
+type SQLCoalesceTest struct {
+	Forwards          []string
+	Backwards         []string
+	ExpectedForwards  []string
+	ExpectedBackwards []string
+	Description       string
+}
+
+var coalesceTests = []SQLCoalesceTest{
+	{
+		Forwards: []string{
+			"ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+			"ALTER TABLE `dogs` MODIFY COLUMN `name` varchar(128) NOT NULL;",
+			"DROP INDEX `idx_name` ON `dogs`;",
+		},
+		Backwards: []string{
+			"ALTER TABLE `dogs` DROP COLUMN `age`;",
+			"ALTER TABLE `dogs` MODIFY COLUMN `name` varchar(64) NOT NULL;",
+			"CREATE UNIQUE INDEX `idx_name` ON `dogs` (`name`);",
+		},
+		ExpectedForwards: []string{
+			"ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL, MODIFY COLUMN `name` varchar(128) NOT NULL, DROP INDEX `idx_name`;",
+		},
+		ExpectedBackwards: []string{
+			"ALTER TABLE `dogs` DROP COLUMN `age`, MODIFY COLUMN `name` varchar(64) NOT NULL, ADD UNIQUE INDEX `idx_name` (`name`);",
+		},
+		Description: "Coalesce column and index changes",
+	},
+	{
+		Forwards: []string{
+			"ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+			"ALTER TABLE `cats` ADD COLUMN `age` int(11) NOT NULL;",
+			"CREATE TABLE `birds` (`id` int(11) NOT NULL) ENGINE=InnoDB DEFAULT CHARSET=latin1;",
+		},
+		Backwards: []string{
+			"ALTER TABLE `dogs` DROP COLUMN `age`;",
+			"ALTER TABLE `cats` DROP COLUMN `age`;",
+			"DROP TABLE `birds`;",
+		},
+		ExpectedForwards: []string{
+			"ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+			"ALTER TABLE `cats` ADD COLUMN `age` int(11) NOT NULL;",
+			"CREATE TABLE `birds` (`id` int(11) NOT NULL) ENGINE=InnoDB DEFAULT CHARSET=latin1;",
+		},
+		ExpectedBackwards: []string{
+			"ALTER TABLE `dogs` DROP COLUMN `age`;",
+			"ALTER TABLE `cats` DROP COLUMN `age`;",
+			"DROP TABLE `birds`;",
+		},
+		Description: "Coalesce doesn't merge different tables",
+	},
+	{
+		Forwards: []string{
+			"ALTER TABLE `dogs` MODIFY COLUMN `id` int(11) NOT NULL;",
+			"ALTER TABLE `dogs` DROP PRIMARY KEY;",
+			"CREATE INDEX `PRIMARY KEY` ON `dogs` (`id`,`name`);",
+			"ALTER TABLE `dogs` MODIFY COLUMN `id` int(11) NOT NULL AUTO_INCREMENT;",
+		},
+		Backwards: []string{
+			"ALTER TABLE `dogs` MODIFY COLUMN `id` int(11) NOT NULL;",
+			"ALTER TABLE `dogs` DROP PRIMARY KEY;",
+			"CREATE INDEX `PRIMARY KEY` ON `dogs` (`id`);",
+			"ALTER TABLE `dogs` MODIFY COLUMN `id` int(11) NOT NULL AUTO_INCREMENT;",
+		},
+		ExpectedForwards: []string{
+			"ALTER TABLE `dogs` MODIFY COLUMN `id` int(11) NOT NULL, DROP PRIMARY KEY;",
+			"ALTER TABLE `dogs` ADD PRIMARY KEY (`id`,`name`), MODIFY COLUMN `id` int(11) NOT NULL AUTO_INCREMENT;",
+		},
+		ExpectedBackwards: []string{
+			"ALTER TABLE `dogs` MODIFY COLUMN `id` int(11) NOT NULL, DROP PRIMARY KEY;",
+			"ALTER TABLE `dogs` ADD PRIMARY KEY (`id`), MODIFY COLUMN `id` int(11) NOT NULL AUTO_INCREMENT;",
+		},
+		Description: "Coalesce splits statements which change the same column twice",
+	},
+	{
+		Forwards: []string{
+			"ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+			"ALTER TABLE `dogs` RENAME TO `hounds`;",
+			"ALTER TABLE `hounds` ADD PARTITION (PARTITION `p2` VALUES LESS THAN (2000));",
+			"ALTER TABLE `hounds` COMMENT='Good dogs';",
+		},
+		Backwards: []string{
+			"ALTER TABLE `dogs` DROP COLUMN `age`;",
+			"ALTER TABLE `hounds` RENAME TO `dogs`;",
+			"ALTER TABLE `hounds` DROP PARTITION `p2`;",
+			"ALTER TABLE `hounds` COMMENT='';",
+		},
+		ExpectedForwards: []string{
+			"ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+			"ALTER TABLE `dogs` RENAME TO `hounds`;",
+			"ALTER TABLE `hounds` ADD PARTITION (PARTITION `p2` VALUES LESS THAN (2000));",
+			"ALTER TABLE `hounds` COMMENT='Good dogs';",
+		},
+		ExpectedBackwards: []string{
+			"ALTER TABLE `dogs` DROP COLUMN `age`;",
+			"ALTER TABLE `hounds` RENAME TO `dogs`;",
+			"ALTER TABLE `hounds` DROP PARTITION `p2`;",
+			"ALTER TABLE `hounds` COMMENT='';",
+		},
+		Description: "Coalesce doesn't merge table renames or partition changes",
+	},
+}
+
+func TestCoalesceAlters(t *testing.T) {
+	toOperations := func(statements []string, op int) (ops SQLOperations) {
+		for i, statement := range statements {
+			ops.Add(SQLOperation{
+				Statement: statement,
+				Op:        op,
+				Metadata: metadata.Metadata{
+					MDID: int64(i + 1),
+				},
+			})
+		}
+		return ops
+	}
+
+	for _, test := range coalesceTests {
+		forwards, backwards := CoalesceAlters(toOperations(test.Forwards, table.Add), toOperations(test.Backwards, table.Del))
+
+		if len(forwards) != len(test.ExpectedForwards) || len(backwards) != len(test.ExpectedBackwards) {
+			t.Errorf("%s FAILED. Expected: [%d] operations Generated Forwards: [%d] Backwards: [%d]", test.Description, len(test.ExpectedForwards), len(forwards), len(backwards))
+			continue
+		}
+
+		merged := 0
+		for i := 0; i < len(forwards); i++ {
+			if forwards[i].Statement != test.ExpectedForwards[i] {
+				t.Errorf("%s FAILED. Forward statement differs", test.Description)
+				util.DebugDiffString(test.ExpectedForwards[i], forwards[i].Statement)
+			}
+			if backwards[i].Statement != test.ExpectedBackwards[i] {
+				t.Errorf("%s FAILED. Backward statement differs", test.Description)
+				util.DebugDiffString(test.ExpectedBackwards[i], backwards[i].Statement)
+			}
+			if len(forwards[i].Operations) != len(backwards[i].Operations) {
+				t.Errorf("%s FAILED. Forward and Backward operations don't match", test.Description)
+			}
+			if len(forwards[i].Operations) > 0 {
+				if forwards[i].Metadata.MDID != forwards[i].Operations[0].Metadata.MDID {
+					t.Errorf("%s FAILED. Metadata of the first operation wasn't kept", test.Description)
+				}
+				merged += len(forwards[i].Operations)
+			} else {
+				merged++
+			}
+		}
+
+		// Every operation must be kept so that all of the Metadata is updated
+		if merged != len(test.Forwards) {
+			t.Errorf("%s FAILED. Expected: [%d] operations to be kept, found: [%d]", test.Description, len(test.Forwards), merged)
+		}
+	}
+}
+
+type SQLAlgorithmTest struct {
+	Diff        table.Diff
+	Strategy    string
+	Algorithm   string
+	Statement   string
+	Description string
+}
+
+var algorithmTests = []SQLAlgorithmTest{
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "Columns",
+			Op:       table.Add,
+			Property: "age",
+			Value: table.Column{
+				Name: "age",
+				Type: "int",
+				Size: []int{11},
+			},
+		},
+		Strategy:    DDLStrategyInstant,
+		Algorithm:   AlgorithmInstant,
+		Statement:   "ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL, ALGORITHM=INSTANT;",
+		Description: "Append column is INSTANT",
+	},
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "Columns",
+			Op:       table.Add,
+			Property: "age",
+			Value: table.Column{
+				Name: "age",
+				Type: "int",
+				Size: []int{11},
+			},
+		},
+		Strategy:    DDLStrategyInplace,
+		Algorithm:   AlgorithmInstant,
+		Statement:   "ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL, ALGORITHM=INPLACE, LOCK=NONE;",
+		Description: "Append column is INPLACE without INSTANT support",
+	},
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "Columns",
+			Op:       table.Add,
+			Property: "age",
+			Value: table.Column{
+				Name: "age",
+				Type: "int",
+				Size: []int{11},
+			},
+		},
+		Strategy:    DDLStrategyNone,
+		Algorithm:   AlgorithmInstant,
+		Statement:   "ALTER TABLE `dogs` ADD COLUMN `age` int(11) NOT NULL;",
+		Description: "No clauses are appended without a strategy",
+	},
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "Columns",
+			Op:       table.Mod,
+			Property: "Type",
+			Value: table.DiffPair{
+				From: table.Column{
+					Name: "name",
+					Type: "varchar",
+					Size: []int{64},
+				},
+				To: table.Column{
+					Name: "name",
+					Type: "text",
+				},
+			},
+		},
+		Strategy:    DDLStrategyInstant,
+		Algorithm:   AlgorithmCopy,
+		Statement:   "ALTER TABLE `dogs` MODIFY COLUMN `name` text NOT NULL, ALGORITHM=COPY, LOCK=SHARED;",
+		Description: "Column type change is COPY",
+	},
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "Columns",
+			Op:       table.Mod,
+			Property: "Name",
+			Value: table.DiffPair{
+				From: table.Column{
+					Name: "name",
+					Type: "varchar",
+					Size: []int{64},
+				},
+				To: table.Column{
+					Name: "title",
+					Type: "varchar",
+					Size: []int{64},
+				},
+			},
+		},
+		Strategy:    DDLStrategyInstant,
+		Algorithm:   AlgorithmInplace,
+		Statement:   "ALTER TABLE `dogs` CHANGE COLUMN `name` `title` varchar(64) NOT NULL, ALGORITHM=INPLACE, LOCK=NONE;",
+		Description: "Column rename is INPLACE",
+	},
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "SecondaryIndexes",
+			Op:       table.Add,
+			Property: "idx_name",
+			Value: table.Index{
+				Name: "idx_name",
+				Columns: []table.IndexColumn{
+					{Name: "name"},
+				},
+			},
+		},
+		Strategy:    DDLStrategyInstant,
+		Algorithm:   AlgorithmInplace,
+		Statement:   "CREATE INDEX `idx_name` ON `dogs` (`name`) ALGORITHM=INPLACE LOCK=NONE;",
+		Description: "Index add is INPLACE",
+	},
+	{
+		Diff: table.Diff{
+			Table:    "dogs",
+			Field:    "*",
+			Op:       table.Del,
+			Property: "*",
+		},
+		Strategy:    DDLStrategyInstant,
+		Algorithm:   "",
+		Statement:   "DROP TABLE `dogs`;",
+		Description: "Drop table isn't classified",
+	},
+}
+
+func TestApplyAlgorithm(t *testing.T) {
+	for _, test := range algorithmTests {
+		results := GenerateAlters(table.Differences{Slice: []table.Diff{test.Diff}})
+
+		if len(results) != 1 {
+			t.Errorf("%s FAILED. Expected a single operation, Generated: [%d]", test.Description, len(results))
+			continue
+		}
+
+		if results[0].Algorithm != test.Algorithm {
+			t.Errorf("%s FAILED. Expected Algorithm: [%s] Classified: [%s]", test.Description, test.Algorithm, results[0].Algorithm)
+		}
+
+		result, err := ApplyAlgorithm(results[0], test.Strategy)
+		if err != nil {
+			t.Errorf("%s FAILED. Error: %v", test.Description, err)
+		}
+
+		if result.Statement != test.Statement {
+			t.Errorf("%s FAILED.", test.Description)
+			util.DebugDiffString(test.Statement, result.Statement)
+		}
+	}
+
+	if _, err := ApplyAlgorithm(SQLOperation{Algorithm: AlgorithmCopy}, "fastest"); err == nil {
+		t.Errorf("Unsupported online DDL strategy FAILED. Expected an error")
+	}
+}
@@ -296,7 +296,165 @@ var colTests = []ParseTest{
 		Description: "Parse Column: `created_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
 	},
 
+	{
+		Str: "`name` varchar(64) NOT NULL DEFAULT 'none' COMMENT 'The user''s NOT NULL DEFAULT name'",
+		Expected: table.Column{
+			Name:     "name",
+			Type:     "varchar",
+			Size:     []int{64},
+			Default:  "none",
+			Nullable: false,
+			Comment:  "The user's NOT NULL DEFAULT name",
+			Metadata: metadata.Metadata{
+				Name:   "name",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: COMMENT containing escaped quotes and keywords",
+	},
+
+	{
+		Str: "`full_name` varchar(128) GENERATED ALWAYS AS (concat(`first`,' (NOT NULL) ',`last`)) VIRTUAL",
+		Expected: table.Column{
+			Name:       "full_name",
+			Type:       "varchar",
+			Size:       []int{128},
+			Nullable:   true,
+			Expression: "concat(`first`,' (NOT NULL) ',`last`)",
+			Storage:    table.ColumnVirtual,
+			Metadata: metadata.Metadata{
+				Name:   "full_name",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: VIRTUAL generated column",
+	},
+	{
+		Str: "`total` int(11) GENERATED ALWAYS AS ((`price` * `quantity`)) STORED NOT NULL COMMENT 'Order total'",
+		Expected: table.Column{
+			Name:       "total",
+			Type:       "int",
+			Size:       []int{11},
+			Nullable:   false,
+			Comment:    "Order total",
+			Expression: "(`price` * `quantity`)",
+			Storage:    table.ColumnStored,
+			Metadata: metadata.Metadata{
+				Name:   "total",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: STORED generated column",
+	},
+
+	{
+		Str: "`size` enum('small','medium','large') NOT NULL DEFAULT 'small'",
+		Expected: table.Column{
+			Name:     "size",
+			Type:     "enum",
+			Values:   []string{"small", "medium", "large"},
+			Default:  "small",
+			Nullable: false,
+			Metadata: metadata.Metadata{
+				Name:   "size",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: enum members",
+	},
+	{
+		Str: "`tags` set('it''s','a, b','(c)') CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT NULL",
+		Expected: table.Column{
+			Name:      "tags",
+			Type:      "set",
+			Values:    []string{"it's", "a, b", "(c)"},
+			Default:   "NULL",
+			Nullable:  true,
+			CharSet:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+			Metadata: metadata.Metadata{
+				Name:   "tags",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: set members with quotes, commas and brackets and a CHARACTER SET",
+	},
+	{
+		Str: "`name` varchar(64) CHARACTER SET latin1 NOT NULL",
+		Expected: table.Column{
+			Name:     "name",
+			Type:     "varchar",
+			Size:     []int{64},
+			CharSet:  "latin1",
+			Nullable: false,
+			Metadata: metadata.Metadata{
+				Name:   "name",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: varchar with CHARACTER SET",
+	},
+
 	// Test malformed sql parse fails
+	{
+		Str:         "`age` int(11) COMMENT 'unterminated",
+		ExpectFail:  true,
+		Description: "Parse Column: Test FAIL unterminated comment",
+	},
+	{
+		Str:         "`total` int(11) GENERATED ALWAYS AS ((`price` * `quantity`) STORED",
+		ExpectFail:  true,
+		Description: "Parse Column: Test FAIL unterminated generated expression",
+	},
+	{
+		Str:         "`size` enum('small','medium NOT NULL",
+		ExpectFail:  true,
+		Description: "Parse Column: Test FAIL unterminated enum members",
+	},
+	// Spatial types
+	{
+		Str: "`location` point NOT NULL /*!80003 SRID 4326 */",
+		Expected: table.Column{
+			Name:     "location",
+			Type:     "point",
+			Nullable: false,
+			SRID:     4326,
+			Metadata: metadata.Metadata{
+				Name:   "location",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: point with SRID",
+	},
+	{
+		Str: "`area` multipolygon SRID 0",
+		Expected: table.Column{
+			Name:     "area",
+			Type:     "multipolygon",
+			Nullable: true,
+			Metadata: metadata.Metadata{
+				Name:   "area",
+				Type:   "Column",
+				Exists: true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Column: multipolygon with unversioned SRID",
+	},
 	{
 		Str:         "`age` NOT NULL",
 		ExpectFail:  true,
@@ -475,6 +633,114 @@ var indexTests = []ParseTest{
 		ExpectFail:  false,
 		Description: "Parse Index: Test partial column",
 	},
+
+	{
+		Str: "FULLTEXT KEY `idx_body` (`body`)",
+		Expected: table.Index{
+			Name: "idx_body",
+			Columns: []table.IndexColumn{
+				{
+					Name: "body",
+				},
+			},
+			Kind: table.IndexFullText,
+			Metadata: metadata.Metadata{
+				PropertyID: "idx_body",
+				ParentID:   "testtbl",
+				Name:       "idx_body",
+				Type:       "Index",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Index: Test FULLTEXT",
+	},
+	{
+		Str: "FULLTEXT KEY `idx_body` (`body`) /*!50100 WITH PARSER `ngram` */ ",
+		Expected: table.Index{
+			Name: "idx_body",
+			Columns: []table.IndexColumn{
+				{
+					Name: "body",
+				},
+			},
+			Kind:   table.IndexFullText,
+			Parser: "ngram",
+			Metadata: metadata.Metadata{
+				PropertyID: "idx_body",
+				ParentID:   "testtbl",
+				Name:       "idx_body",
+				Type:       "Index",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Index: Test FULLTEXT WITH PARSER",
+	},
+	{
+		Str: "SPATIAL KEY `idx_location` (`location`)",
+		Expected: table.Index{
+			Name: "idx_location",
+			Columns: []table.IndexColumn{
+				{
+					Name: "location",
+				},
+			},
+			Kind: table.IndexSpatial,
+			Metadata: metadata.Metadata{
+				PropertyID: "idx_location",
+				ParentID:   "testtbl",
+				Name:       "idx_location",
+				Type:       "Index",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Index: Test SPATIAL",
+	},
+	{
+		Str: "KEY `idx_name` (`name`) USING HASH",
+		Expected: table.Index{
+			Name: "idx_name",
+			Columns: []table.IndexColumn{
+				{
+					Name: "name",
+				},
+			},
+			Using: "HASH",
+			Metadata: metadata.Metadata{
+				PropertyID: "idx_name",
+				ParentID:   "testtbl",
+				Name:       "idx_name",
+				Type:       "Index",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Index: Test USING after columns",
+	},
+	{
+		Str: "UNIQUE KEY `idx_name` USING BTREE (`name`)",
+		Expected: table.Index{
+			Name: "idx_name",
+			Columns: []table.IndexColumn{
+				{
+					Name: "name",
+				},
+			},
+			IsUnique: true,
+			Using:    "BTREE",
+			Metadata: metadata.Metadata{
+				PropertyID: "idx_name",
+				ParentID:   "testtbl",
+				Name:       "idx_name",
+				Type:       "Index",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Index: Test USING before columns",
+	},
 }
 
 var pkTests = []ParseTest{
@@ -572,6 +838,251 @@ var pkTests = []ParseTest{
 	},
 }
 
+var fkTests = []ParseTest{
+	{
+		Str: "CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)",
+		Expected: table.ForeignKey{
+			Name:             "fk_user",
+			Columns:          []string{"user_id"},
+			ReferenceTable:   "user",
+			ReferenceColumns: []string{"id"},
+			Metadata: metadata.Metadata{
+				PropertyID: "fk_user",
+				ParentID:   "testtbl",
+				Name:       "fk_user",
+				Type:       "ForeignKey",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Foreign Key: Test single column",
+	},
+	{
+		Str: "CONSTRAINT `fk_order` FOREIGN KEY (`user_id`,`order_id`) REFERENCES `order` (`user_id`,`id`) ON DELETE CASCADE ON UPDATE SET NULL",
+		Expected: table.ForeignKey{
+			Name:             "fk_order",
+			Columns:          []string{"user_id", "order_id"},
+			ReferenceTable:   "order",
+			ReferenceColumns: []string{"user_id", "id"},
+			OnDelete:         "CASCADE",
+			OnUpdate:         "SET NULL",
+			Metadata: metadata.Metadata{
+				PropertyID: "fk_order",
+				ParentID:   "testtbl",
+				Name:       "fk_order",
+				Type:       "ForeignKey",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Foreign Key: Test multiple columns with actions",
+	},
+	{
+		Str: "CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON UPDATE CASCADE",
+		Expected: table.ForeignKey{
+			Name:             "fk_user",
+			Columns:          []string{"user_id"},
+			ReferenceTable:   "user",
+			ReferenceColumns: []string{"id"},
+			OnUpdate:         "CASCADE",
+			Metadata: metadata.Metadata{
+				PropertyID: "fk_user",
+				ParentID:   "testtbl",
+				Name:       "fk_user",
+				Type:       "ForeignKey",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Foreign Key: Test ON UPDATE only",
+	},
+	{
+		Str: "CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE RESTRICT ON UPDATE NO ACTION",
+		Expected: table.ForeignKey{
+			Name:             "fk_user",
+			Columns:          []string{"user_id"},
+			ReferenceTable:   "user",
+			ReferenceColumns: []string{"id"},
+			Metadata: metadata.Metadata{
+				PropertyID: "fk_user",
+				ParentID:   "testtbl",
+				Name:       "fk_user",
+				Type:       "ForeignKey",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Foreign Key: Test default actions are omitted",
+	},
+	// Test failures
+	{
+		Str:         "CONSTRAINT `fk_user` FOREIGN KEY (`user_id`)",
+		Expected:    table.ForeignKey{},
+		ExpectFail:  true,
+		Description: "Parse Foreign Key: Test missing REFERENCES",
+	},
+	{
+		Str:         "CONSTRAINT FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)",
+		Expected:    table.ForeignKey{},
+		ExpectFail:  true,
+		Description: "Parse Foreign Key: Test missing name",
+	},
+	{
+		Str:         "CONSTRAINT `fk_user` FOREIGN KEY () REFERENCES `user` (`id`)",
+		Expected:    table.ForeignKey{},
+		ExpectFail:  true,
+		Description: "Parse Foreign Key: Test missing columns",
+	},
+}
+
+var checkTests = []ParseTest{
+	{
+		Str: "CONSTRAINT `chk_price` CHECK ((`price` > 0))",
+		Expected: table.CheckConstraint{
+			Name:       "chk_price",
+			Expression: "(`price` > 0)",
+			Metadata: metadata.Metadata{
+				PropertyID: "chk_price",
+				ParentID:   "testtbl",
+				Name:       "chk_price",
+				Type:       "CheckConstraint",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Check Constraint: Test single column",
+	},
+	{
+		Str: "CONSTRAINT `chk_dates` CHECK ((`end` > `start`)) /*!80016 NOT ENFORCED */",
+		Expected: table.CheckConstraint{
+			Name:        "chk_dates",
+			Expression:  "(`end` > `start`)",
+			NotEnforced: true,
+			Metadata: metadata.Metadata{
+				PropertyID: "chk_dates",
+				ParentID:   "testtbl",
+				Name:       "chk_dates",
+				Type:       "CheckConstraint",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Check Constraint: Test NOT ENFORCED",
+	},
+	{
+		Str: "CONSTRAINT `chk_code` CHECK ((`code` <> _utf8mb4')'))",
+		Expected: table.CheckConstraint{
+			Name:       "chk_code",
+			Expression: "(`code` <> _utf8mb4')')",
+			Metadata: metadata.Metadata{
+				PropertyID: "chk_code",
+				ParentID:   "testtbl",
+				Name:       "chk_code",
+				Type:       "CheckConstraint",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Check Constraint: Test bracket in string literal",
+	},
+	// Test failures
+	{
+		Str:         "CONSTRAINT CHECK ((`price` > 0))",
+		Expected:    table.CheckConstraint{},
+		ExpectFail:  true,
+		Description: "Parse Check Constraint: Test missing name",
+	},
+	{
+		Str:         "CONSTRAINT `chk_price` CHECK ((`price` > 0)",
+		Expected:    table.CheckConstraint{},
+		ExpectFail:  true,
+		Description: "Parse Check Constraint: Test unterminated expression",
+	},
+}
+
+var partitionTests = []ParseTest{
+	{
+		Str: "/*!50100 PARTITION BY RANGE (to_days(`created`)) (PARTITION p2017 VALUES LESS THAN (736695) ENGINE = InnoDB PARTITION pmax VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+		Expected: table.Partitioning{
+			Type:       table.PartitionRange,
+			Expression: "to_days(`created`)",
+			Partitions: []table.Partition{
+				{
+					Name:   "p2017",
+					Values: "(736695)",
+				},
+				{
+					Name:   "pmax",
+					Values: "MAXVALUE",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Partitioning: Test RANGE",
+	},
+	{
+		Str: "/*!50500 PARTITION BY RANGE  COLUMNS(created) (PARTITION p2017 VALUES LESS THAN ('2018-01-01') ENGINE = InnoDB, PARTITION pmax VALUES LESS THAN (MAXVALUE) ENGINE = InnoDB) */",
+		Expected: table.Partitioning{
+			Type:       table.PartitionRange,
+			Columns:    true,
+			Expression: "created",
+			Partitions: []table.Partition{
+				{
+					Name:   "p2017",
+					Values: "('2018-01-01')",
+				},
+				{
+					Name:   "pmax",
+					Values: "(MAXVALUE)",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Partitioning: Test RANGE COLUMNS",
+	},
+	{
+		Str: "/*!50100 PARTITION BY LIST (`region`) (PARTITION p_east VALUES IN (1,2) ENGINE = InnoDB, PARTITION p_west VALUES IN (3) ENGINE = InnoDB) */",
+		Expected: table.Partitioning{
+			Type:       table.PartitionList,
+			Expression: "`region`",
+			Partitions: []table.Partition{
+				{
+					Name:   "p_east",
+					Values: "(1,2)",
+				},
+				{
+					Name:   "p_west",
+					Values: "(3)",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Parse Partitioning: Test LIST",
+	},
+	{
+		Str: "/*!50100 PARTITION BY LINEAR HASH (`id`) PARTITIONS 4 */",
+		Expected: table.Partitioning{
+			Type:       table.PartitionHash,
+			Linear:     true,
+			Expression: "`id`",
+			Count:      4,
+		},
+		ExpectFail:  false,
+		Description: "Parse Partitioning: Test LINEAR HASH",
+	},
+	// Test failures
+	{
+		Str:         "/*!50100 PARTITION BY RANGE to_days(`created`) */",
+		ExpectFail:  true,
+		Description: "Parse Partitioning: Test malformed expression",
+	},
+	{
+		Str:         "/*!50100 PARTITION BY SYSTEM_TIME (`id`) */",
+		ExpectFail:  true,
+		Description: "Parse Partitioning: Test unsupported type",
+	},
+}
+
 func validateResult(test ParseTest, result interface{}, err error, t *testing.T) {
 
 	if !test.ExpectFail && err != nil {
@@ -652,3 +1163,39 @@ func TestPKParse(t *testing.T) {
 	}
 	mgmtDb.ExpectionsMet("TestPKParse", t)
 }
+
+func TestForeignKeyParse(t *testing.T) {
+	var err error
+	var result table.ForeignKey
+
+	mgmtDb, err := test.CreateManagementDB("TestForeignKeyParse", t)
+
+	metadata.Setup(mgmtDb.Db, 1)
+
+	for _, test := range fkTests {
+
+		result, err = buildForeignKey(test.Str, tblPropertyID, tblName)
+		validateResult(test, result, err, t)
+	}
+	mgmtDb.ExpectionsMet("TestForeignKeyParse", t)
+}
+
+func TestCheckConstraintParse(t *testing.T) {
+	var err error
+	var result table.CheckConstraint
+
+	for _, test := range checkTests {
+		result, err = buildCheckConstraint(test.Str, tblPropertyID, tblName)
+		validateResult(test, result, err, t)
+	}
+}
+
+func TestPartitionParse(t *testing.T) {
+	var err error
+	var result table.Partitioning
+
+	for _, test := range partitionTests {
+		result, err = buildPartitioning(test.Str)
+		validateResult(test, result, err, t)
+	}
+}
@@ -752,6 +752,403 @@ var parseCreateTableTests = []SQLParseCTTest{
 		ExpectFail:  false,
 		Description: "Create Table: PrimaryKey with partial index",
 	},
+
+	{
+		CTStatement: []string{
+			"CREATE TABLE `test` (",
+			"`id` int(11) NOT NULL COMMENT 'Unique id', ",
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1 COMMENT='The test''s COLLATE=fake table'",
+		},
+		Metadata: []test.DBRow{
+			test.DBRow{1, 1, "tbl1", "", "Table", "test", 1},
+			test.DBRow{2, 1, "col1", "tbl1", "Column", "id", 1},
+		},
+		Expected: table.Table{
+			Name:    "test",
+			Engine:  "InnoDB",
+			CharSet: "latin1",
+			Comment: "The test's COLLATE=fake table",
+			Columns: []table.Column{
+				{
+					Name:    "id",
+					Type:    "int",
+					Size:    []int{11},
+					Comment: "Unique id",
+					Metadata: metadata.Metadata{
+						MDID:       2,
+						DB:         1,
+						PropertyID: "col1",
+						ParentID:   "tbl1",
+						Name:       "id",
+						Type:       "Column",
+						Exists:     true,
+					},
+				},
+			},
+			Filename: "DB",
+			Metadata: metadata.Metadata{
+				MDID:       1,
+				DB:         1,
+				PropertyID: "tbl1",
+				ParentID:   "",
+				Name:       "test",
+				Type:       "Table",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Create Table: Table and Column Comments",
+	},
+
+	{
+		CTStatement: []string{
+			"CREATE TABLE `test` (",
+			"`created` date NOT NULL, ",
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1",
+			"/*!50100 PARTITION BY RANGE (to_days(`created`))",
+			"(PARTITION p2017 VALUES LESS THAN (736695) ENGINE = InnoDB,",
+			" PARTITION pmax VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+		},
+		Metadata: []test.DBRow{
+			test.DBRow{1, 1, "tbl1", "", "Table", "test", 1},
+			test.DBRow{2, 1, "col1", "tbl1", "Column", "created", 1},
+		},
+		Expected: table.Table{
+			Name:    "test",
+			Engine:  "InnoDB",
+			CharSet: "latin1",
+			Columns: []table.Column{
+				{
+					Name: "created",
+					Type: "date",
+					Metadata: metadata.Metadata{
+						MDID:       2,
+						DB:         1,
+						PropertyID: "col1",
+						ParentID:   "tbl1",
+						Name:       "created",
+						Type:       "Column",
+						Exists:     true,
+					},
+				},
+			},
+			Partitioning: table.Partitioning{
+				Type:       table.PartitionRange,
+				Expression: "to_days(`created`)",
+				Partitions: []table.Partition{
+					{
+						Name:   "p2017",
+						Values: "(736695)",
+					},
+					{
+						Name:   "pmax",
+						Values: "MAXVALUE",
+					},
+				},
+			},
+			Filename: "DB",
+			Metadata: metadata.Metadata{
+				MDID:       1,
+				DB:         1,
+				PropertyID: "tbl1",
+				ParentID:   "",
+				Name:       "test",
+				Type:       "Table",
+				Exists:     true,
+			},
+		},
+		ExpectFail:  false,
+		Description: "Create Table: RANGE Partitioning",
+	},
+
+	{
+		CTStatement: spatialCreateTable,
+		Metadata:    spatialMetadata,
+		Expected:    spatialTable,
+		ExpectFail:  false,
+		Description: "Create Table: Spatial Columns and Index",
+	},
+}
+
+// spatialCreateTable A table with spatial columns as output by SHOW CREATE TABLE
+var spatialCreateTable = []string{
+	"CREATE TABLE `places` (",
+	"`id` int(11) NOT NULL, ",
+	"`location` point NOT NULL /*!80003 SRID 4326 */, ",
+	"`boundary` geometry NOT NULL, ",
+	"PRIMARY KEY (`id`), ",
+	"SPATIAL KEY `idx_location` (`location`)",
+	") ENGINE=InnoDB DEFAULT CHARSET=latin1",
+}
+
+var spatialMetadata = []test.DBRow{
+	test.DBRow{1, 1, "tbl1", "", "Table", "places", 1},
+	test.DBRow{2, 1, "col1", "tbl1", "Column", "id", 1},
+	test.DBRow{3, 1, "col2", "tbl1", "Column", "location", 1},
+	test.DBRow{4, 1, "col3", "tbl1", "Column", "boundary", 1},
+	test.DBRow{5, 1, "pk1", "tbl1", "PrimaryKey", "PrimaryKey", 1},
+	test.DBRow{6, 1, "idx1", "tbl1", "Index", "idx_location", 1},
+}
+
+var spatialTable = table.Table{
+	Name:    "places",
+	Engine:  "InnoDB",
+	CharSet: "latin1",
+	Columns: []table.Column{
+		{
+			Name: "id",
+			Type: "int",
+			Size: []int{11},
+			Metadata: metadata.Metadata{
+				MDID:       2,
+				DB:         1,
+				PropertyID: "col1",
+				ParentID:   "tbl1",
+				Name:       "id",
+				Type:       "Column",
+				Exists:     true,
+			},
+		},
+		{
+			Name: "location",
+			Type: "point",
+			SRID: 4326,
+			Metadata: metadata.Metadata{
+				MDID:       3,
+				DB:         1,
+				PropertyID: "col2",
+				ParentID:   "tbl1",
+				Name:       "location",
+				Type:       "Column",
+				Exists:     true,
+			},
+		},
+		{
+			Name: "boundary",
+			Type: "geometry",
+			Metadata: metadata.Metadata{
+				MDID:       4,
+				DB:         1,
+				PropertyID: "col3",
+				ParentID:   "tbl1",
+				Name:       "boundary",
+				Type:       "Column",
+				Exists:     true,
+			},
+		},
+	},
+	PrimaryIndex: table.Index{
+		Name:      "PrimaryKey",
+		IsPrimary: true,
+		Columns: []table.IndexColumn{
+			{
+				Name: "id",
+			},
+		},
+		Metadata: metadata.Metadata{
+			MDID:       5,
+			DB:         1,
+			PropertyID: "pk1",
+			ParentID:   "tbl1",
+			Name:       "PrimaryKey",
+			Type:       "PrimaryKey",
+			Exists:     true,
+		},
+	},
+	SecondaryIndexes: []table.Index{
+		{
+			Name: "idx_location",
+			Kind: table.IndexSpatial,
+			Columns: []table.IndexColumn{
+				{
+					Name: "location",
+				},
+			},
+			Metadata: metadata.Metadata{
+				MDID:       6,
+				DB:         1,
+				PropertyID: "idx1",
+				ParentID:   "tbl1",
+				Name:       "idx_location",
+				Type:       "Index",
+				Exists:     true,
+			},
+		},
+	},
+	Filename: "DB",
+	Metadata: metadata.Metadata{
+		MDID:       1,
+		DB:         1,
+		PropertyID: "tbl1",
+		ParentID:   "",
+		Name:       "places",
+		Type:       "Table",
+		Exists:     true,
+	},
+}
+
+type SQLParseCVTest struct {
+	Statement   string
+	Metadata    []test.DBRow
+	Expected    table.View
+	Description string
+}
+
+var parseCreateViewTests = []SQLParseCVTest{
+	{
+		Statement: "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`localhost` SQL SECURITY DEFINER VIEW `active_dogs` AS select `dogs`.`id` AS `id` from `dogs` where (`dogs`.`active` = 1)",
+		Metadata: []test.DBRow{
+			test.DBRow{1, 1, "view1", "", "View", "active_dogs", 1},
+		},
+		Expected: table.View{
+			Name:       "active_dogs",
+			Definition: "select `dogs`.`id` AS `id` from `dogs` where (`dogs`.`active` = 1)",
+			Algorithm:  "UNDEFINED",
+			Security:   "DEFINER",
+			Filename:   "DB",
+			Metadata: metadata.Metadata{
+				MDID:       1,
+				DB:         1,
+				PropertyID: "view1",
+				Type:       "View",
+				Name:       "active_dogs",
+				Exists:     true,
+			},
+		},
+		Description: "Create View: Basic View",
+	},
+	{
+		Statement: "CREATE ALGORITHM=MERGE DEFINER=`root`@`%` SQL SECURITY INVOKER VIEW `big_dogs` AS select `dogs`.`id` AS `id`,`dogs`.`size` AS `size` from `dogs` where (`dogs`.`size` > 10) WITH CASCADED CHECK OPTION",
+		Metadata: []test.DBRow{
+			test.DBRow{2, 1, "view2", "", "View", "big_dogs", 1},
+		},
+		Expected: table.View{
+			Name:        "big_dogs",
+			Definition:  "select `dogs`.`id` AS `id`,`dogs`.`size` AS `size` from `dogs` where (`dogs`.`size` > 10)",
+			Algorithm:   "MERGE",
+			Security:    "INVOKER",
+			CheckOption: "CASCADED",
+			Filename:    "DB",
+			Metadata: metadata.Metadata{
+				MDID:       2,
+				DB:         1,
+				PropertyID: "view2",
+				Type:       "View",
+				Name:       "big_dogs",
+				Exists:     true,
+			},
+		},
+		Description: "Create View: Options and CHECK OPTION",
+	},
+}
+
+type SQLParseCRTest struct {
+	Statement   string
+	Metadata    []test.DBRow
+	Expected    table.Routine
+	Description string
+}
+
+var parseCreateRoutineTests = []SQLParseCRTest{
+	{
+		Statement: "CREATE DEFINER=`root`@`localhost` TRIGGER `dogs_audit` BEFORE UPDATE ON `dogs` FOR EACH ROW SET NEW.updated = NOW()",
+		Metadata: []test.DBRow{
+			test.DBRow{1, 1, "trigger1", "", "Trigger", "dogs_audit", 1},
+		},
+		Expected: table.Routine{
+			Name:       "dogs_audit",
+			Type:       "TRIGGER",
+			Definition: "CREATE TRIGGER `dogs_audit` BEFORE UPDATE ON `dogs` FOR EACH ROW SET NEW.updated = NOW()",
+			Filename:   "DB",
+			Metadata: metadata.Metadata{
+				MDID:       1,
+				DB:         1,
+				PropertyID: "trigger1",
+				Type:       "Trigger",
+				Name:       "dogs_audit",
+				Exists:     true,
+			},
+		},
+		Description: "Create Routine: Trigger",
+	},
+	{
+		Statement: "CREATE DEFINER=`root`@`%` FUNCTION `dog_age`(born DATE) RETURNS int(11)\n    DETERMINISTIC\nRETURN TIMESTAMPDIFF(YEAR, born, CURDATE())",
+		Metadata: []test.DBRow{
+			test.DBRow{2, 1, "function1", "", "Function", "dog_age", 1},
+		},
+		Expected: table.Routine{
+			Name:       "dog_age",
+			Type:       "FUNCTION",
+			Definition: "CREATE FUNCTION `dog_age`(born DATE) RETURNS int(11)\n    DETERMINISTIC\nRETURN TIMESTAMPDIFF(YEAR, born, CURDATE())",
+			Filename:   "DB",
+			Metadata: metadata.Metadata{
+				MDID:       2,
+				DB:         1,
+				PropertyID: "function1",
+				Type:       "Function",
+				Name:       "dog_age",
+				Exists:     true,
+			},
+		},
+		Description: "Create Routine: Function with parameters",
+	},
+}
+
+type SQLParseCETest struct {
+	Statement   string
+	Metadata    []test.DBRow
+	Expected    table.Event
+	Description string
+}
+
+var parseCreateEventTests = []SQLParseCETest{
+	{
+		Statement: "CREATE DEFINER=`root`@`localhost` EVENT `animals_cleanup` ON SCHEDULE EVERY 1 DAY STARTS '2017-01-01 00:00:00' ON COMPLETION NOT PRESERVE ENABLE DO DELETE FROM `dogs` WHERE `active` = 0",
+		Metadata: []test.DBRow{
+			test.DBRow{1, 1, "event1", "", "Event", "animals_cleanup", 1},
+		},
+		Expected: table.Event{
+			Name:         "animals_cleanup",
+			Schedule:     "EVERY 1 DAY STARTS '2017-01-01 00:00:00'",
+			Status:       "ENABLE",
+			OnCompletion: "NOT PRESERVE",
+			Body:         "DELETE FROM `dogs` WHERE `active` = 0",
+			Filename:     "DB",
+			Metadata: metadata.Metadata{
+				MDID:       1,
+				DB:         1,
+				PropertyID: "event1",
+				Type:       "Event",
+				Name:       "animals_cleanup",
+				Exists:     true,
+			},
+		},
+		Description: "Create Event: Recurring Event",
+	},
+	{
+		Statement: "CREATE DEFINER=`root`@`%` EVENT `purge_logs` ON SCHEDULE AT '2017-06-01 12:00:00' ON COMPLETION PRESERVE DISABLE ON SLAVE COMMENT 'Don''t DO this twice' DO BEGIN\n  DELETE FROM `logs`;\nEND",
+		Metadata: []test.DBRow{
+			test.DBRow{2, 1, "event2", "", "Event", "purge_logs", 1},
+		},
+		Expected: table.Event{
+			Name:         "purge_logs",
+			Schedule:     "AT '2017-06-01 12:00:00'",
+			Status:       "DISABLE ON SLAVE",
+			OnCompletion: "PRESERVE",
+			Comment:      "Don't DO this twice",
+			Body:         "BEGIN\n  DELETE FROM `logs`;\nEND",
+			Filename:     "DB",
+			Metadata: metadata.Metadata{
+				MDID:       2,
+				DB:         1,
+				PropertyID: "event2",
+				Type:       "Event",
+				Name:       "purge_logs",
+				Exists:     true,
+			},
+		},
+		Description: "Create Event: One time Event with a COMMENT",
+	},
 }
 
 var mockDb *sql.DB
@@ -826,6 +1223,170 @@ func TestParseCreateTable(t *testing.T) {
 	mgmtDB.ExpectionsMet("TestParseCreateTable", t)
 }
 
+func TestParseCreateTableRoundTrip(t *testing.T) {
+	testName := "TestParseCreateTableRoundTrip"
+
+	mgmtDB, _ := test.CreateManagementDB(testName, t)
+
+	metadata.Setup(mgmtDB.Db, 1)
+
+	mgmtDB.MetadataSelectName("places", spatialMetadata[0], false)
+	mgmtDB.MetadataLoadAllTableMetadata("places", "tbl1", 1, spatialMetadata, false)
+
+	tbl, err := ParseCreateTable(strings.Join(spatialCreateTable, "\n"))
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+		return
+	}
+
+	// The regenerated statement retains the spatial column types, SRID and index
+	expected := "CREATE TABLE `places` (`id` int(11) NOT NULL,`location` point NOT NULL /*!80003 SRID 4326 */,`boundary` geometry NOT NULL, PRIMARY KEY (`id`),SPATIAL KEY `idx_location` (`location`)) ENGINE=InnoDB DEFAULT CHARSET=latin1;"
+
+	result := generateCreateTable(tbl)
+	if result.Statement != expected {
+		t.Errorf("%s FAILED.", testName)
+		util.LogAttentionf(" Expecting: %s", expected)
+		util.LogErrorf("Generated: %s", result.Statement)
+	}
+
+	mgmtDB.ExpectionsMet(testName, t)
+}
+
+func TestParseCreateView(t *testing.T) {
+
+	mgmtDB, _ := test.CreateManagementDB("TestParseCreateView", t)
+
+	metadata.Setup(mgmtDB.Db, 1)
+
+	for _, tst := range parseCreateViewTests {
+
+		mgmtDB.MetadataSelectName(
+			tst.Expected.Name,
+			tst.Metadata[0],
+			false,
+		)
+
+		mgmtDB.MetadataLoadAllTableMetadata(
+			tst.Expected.Name,
+			tst.Metadata[0][2].(string),
+			1,
+			tst.Metadata,
+			false,
+		)
+
+		result, err := ParseCreateView(tst.Statement)
+
+		if err != nil || !reflect.DeepEqual(result, tst.Expected) {
+
+			context := ""
+			if err != nil {
+				util.LogWarnf("%s FAILED with error: %v", tst.Description, err)
+				context = "Errors while parsing CREATE VIEW statement"
+			} else {
+				util.LogWarnf("%s FAILED.", tst.Description)
+				context = "Parsed View doesn't match"
+				util.DebugDumpDiff(tst.Expected, result)
+			}
+
+			t.Errorf("%s FAILED. %s", tst.Description, context)
+		}
+	}
+
+	mgmtDB.ExpectionsMet("TestParseCreateView", t)
+}
+
+func TestParseCreateRoutine(t *testing.T) {
+
+	mgmtDB, _ := test.CreateManagementDB("TestParseCreateRoutine", t)
+
+	metadata.Setup(mgmtDB.Db, 1)
+
+	for _, tst := range parseCreateRoutineTests {
+
+		mgmtDB.MetadataSelectName(
+			tst.Expected.Name,
+			tst.Metadata[0],
+			false,
+		)
+
+		mgmtDB.MetadataLoadAllTableMetadata(
+			tst.Expected.Name,
+			tst.Metadata[0][2].(string),
+			1,
+			tst.Metadata,
+			false,
+		)
+
+		result, err := ParseCreateRoutine(tst.Statement)
+
+		if err != nil || !reflect.DeepEqual(result, tst.Expected) {
+
+			context := ""
+			if err != nil {
+				util.LogWarnf("%s FAILED with error: %v", tst.Description, err)
+				context = "Errors while parsing CREATE statement"
+			} else {
+				util.LogWarnf("%s FAILED.", tst.Description)
+				context = "Parsed Routine doesn't match"
+				util.DebugDumpDiff(tst.Expected, result)
+			}
+
+			t.Errorf("%s FAILED. %s", tst.Description, context)
+		}
+	}
+
+	// Invalid statements are rejected
+	_, err := ParseCreateRoutine("CREATE EVENT `cleanup` ON SCHEDULE EVERY 1 DAY DO DELETE FROM `dogs`")
+	if err == nil {
+		t.Errorf("Create Routine: Unsupported type FAILED. Expected an error")
+	}
+
+	mgmtDB.ExpectionsMet("TestParseCreateRoutine", t)
+}
+
+func TestParseCreateEvent(t *testing.T) {
+
+	mgmtDB, _ := test.CreateManagementDB("TestParseCreateEvent", t)
+
+	metadata.Setup(mgmtDB.Db, 1)
+
+	for _, tst := range parseCreateEventTests {
+
+		mgmtDB.MetadataSelectName(
+			tst.Expected.Name,
+			tst.Metadata[0],
+			false,
+		)
+
+		mgmtDB.MetadataLoadAllTableMetadata(
+			tst.Expected.Name,
+			tst.Metadata[0][2].(string),
+			1,
+			tst.Metadata,
+			false,
+		)
+
+		result, err := ParseCreateEvent(tst.Statement)
+
+		if err != nil || !reflect.DeepEqual(result, tst.Expected) {
+
+			context := ""
+			if err != nil {
+				util.LogWarnf("%s FAILED with error: %v", tst.Description, err)
+				context = "Errors while parsing CREATE EVENT statement"
+			} else {
+				util.LogWarnf("%s FAILED.", tst.Description)
+				context = "Parsed Event doesn't match"
+				util.DebugDumpDiff(tst.Expected, result)
+			}
+
+			t.Errorf("%s FAILED. %s", tst.Description, context)
+		}
+	}
+
+	mgmtDB.ExpectionsMet("TestParseCreateEvent", t)
+}
+
 func DisableTestParseDump(t *testing.T) {
 
 	filename := "dump.sql"
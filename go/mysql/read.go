@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -30,10 +31,19 @@ const (
 	DEFAULT_COLLATE   = "DEFAULT COLLATE"
 	CURRENT_TIMESTAMP = "CURRENT_TIMESTAMP"
 	ON_UPDATE 		  = "ON UPDATE"
+	COMMENT           = "COMMENT"
+	GENERATED         = "GENERATED ALWAYS AS"
+	CHARACTER_SET     = "CHARACTER SET"
+	EVENT_NAMES       = "select EVENT_NAME from information_schema.EVENTS where EVENT_SCHEMA = database()"
+	ROUTINE_NAMES     = "select TRIGGER_NAME, 'TRIGGER' from information_schema.TRIGGERS where TRIGGER_SCHEMA = database() union all select ROUTINE_NAME, ROUTINE_TYPE from information_schema.ROUTINES where ROUTINE_SCHEMA = database()"
 )
 
 var alters []string
 
+// sridPattern Matches the SRID attribute of a spatial column, which SHOW CREATE TABLE
+// wraps in a version comment e.g. /*!80003 SRID 4326 */
+var sridPattern = regexp.MustCompile(`\s*(?:/\*!\d+\s+)?\bSRID\s+(\d+)(?:\s*\*/)?`)
+
 var datatypes = []string{
 	"char",
 	"varchar",
@@ -63,6 +73,14 @@ var datatypes = []string{
 	"enum",
 	"set",
 	"json",
+	"geometry",
+	"point",
+	"linestring",
+	"polygon",
+	"multipoint",
+	"multilinestring",
+	"multipolygon",
+	"geometrycollection",
 }
 
 var datatypesSizable = []string{
@@ -140,6 +158,304 @@ func extractParameter(s string, param string) (result string, err error) {
 	return result, err
 }
 
+// extractComment Removes the quoted COMMENT clause from the line parameter returning
+// the unescaped comment and the line without the clause.  The clause may optionally
+// use an = separator as used by the table options.
+func extractComment(line string) (comment string, remainder string, err error) {
+	remainder = line
+
+	pos := strings.Index(line, " "+COMMENT+" '")
+	if pos == -1 {
+		pos = strings.Index(line, " "+COMMENT+"='")
+	}
+
+	if pos == -1 {
+		return comment, remainder, err
+	}
+
+	quoted := line[pos+len(COMMENT)+2:]
+
+	comment, remainder, err = util.UnquoteSQLString(quoted)
+	if err != nil {
+		return comment, line, parseError(fmt.Sprintf("Malformed COMMENT definition: [%s]", line))
+	}
+
+	remainder = line[:pos] + remainder
+
+	return comment, remainder, err
+}
+
+// closingBracket Returns the position of the bracket closing the bracket at the start
+// position, ignoring any brackets within string literals.  Returns -1 if not found.
+func closingBracket(s string, start int) int {
+	depth := 0
+	inQuote := false
+
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			inQuote = !inQuote
+		case '(':
+			if !inQuote {
+				depth++
+			}
+		case ')':
+			if !inQuote {
+				depth--
+				if depth == 0 {
+					return i
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// extractValues Removes the bracketed member list from an ENUM or SET column definition in the
+// line parameter returning the unquoted members and the line without the member list.
+func extractValues(line string) (values []string, remainder string, err error) {
+	remainder = line
+
+	// The datatype follows the quoted column name
+	typeStart := strings.Index(line, "` ")
+	if typeStart == -1 {
+		return values, remainder, err
+	}
+	typeStart += 2
+
+	datatype := strings.ToLower(line[typeStart:])
+	if !strings.HasPrefix(datatype, "enum(") && !strings.HasPrefix(datatype, "set(") {
+		return values, remainder, err
+	}
+
+	start := typeStart + strings.Index(datatype, "(")
+	end := closingBracket(line, start)
+	if end == -1 {
+		return values, line, parseError(fmt.Sprintf("Malformed ENUM or SET definition: [%s]", line))
+	}
+
+	members := strings.TrimSpace(line[start+1 : end])
+	for len(members) > 0 {
+		var value string
+		value, members, err = util.UnquoteSQLString(members)
+		if err != nil {
+			return values, line, parseError(fmt.Sprintf("Malformed ENUM or SET member: [%s]", line))
+		}
+		values = append(values, value)
+		members = strings.TrimPrefix(strings.TrimSpace(members), ",")
+		members = strings.TrimSpace(members)
+	}
+
+	remainder = line[:start] + line[end+1:]
+
+	return values, remainder, err
+}
+
+// extractGenerated Removes the GENERATED ALWAYS AS (<expr>) [VIRTUAL|STORED] clause from the
+// line parameter returning the expression, the storage type and the line without the clause.
+func extractGenerated(line string) (expression string, storage string, remainder string, err error) {
+	remainder = line
+
+	pos := strings.Index(strings.ToUpper(line), " "+GENERATED+" (")
+	if pos == -1 {
+		return expression, storage, remainder, err
+	}
+
+	start := pos + len(GENERATED) + 2
+	end := closingBracket(line, start)
+
+	if end == -1 {
+		return expression, storage, line, parseError(fmt.Sprintf("Invalid Column Definition: Malformed generated column expression: [%s]", line))
+	}
+
+	expression = strings.TrimSpace(line[start+1 : end])
+	rest := line[end+1:]
+
+	storage = table.ColumnVirtual
+	trimmed := strings.TrimSpace(rest)
+	for _, st := range []string{table.ColumnVirtual, table.ColumnStored} {
+		if strings.HasPrefix(strings.ToUpper(trimmed), st) {
+			storage = st
+			rest = trimmed[len(st):]
+			break
+		}
+	}
+
+	remainder = line[:pos] + rest
+
+	return expression, storage, remainder, err
+}
+
+// extractSRID Extracts the SRID attribute of a spatial column from the line
+// and returns the line without it.  Returns 0 if no SRID has been defined.
+func extractSRID(line string) (srid int, remainder string, err error) {
+	remainder = line
+
+	match := sridPattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		return srid, remainder, err
+	}
+
+	srid, err = strconv.Atoi(line[match[2]:match[3]])
+	if err != nil {
+		return srid, line, parseError(fmt.Sprintf("Invalid Column Definition: Malformed SRID: [%s]", line))
+	}
+
+	remainder = line[:match[0]] + line[match[1]:]
+
+	return srid, remainder, err
+}
+
+// isPartitionDefinition Helper function. Does the line begin the table partitioning definition
+func isPartitionDefinition(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, prefix := range []string{"/*!50100 ", "/*!50500 "} {
+		line = strings.TrimPrefix(line, prefix)
+	}
+	return strings.HasPrefix(line, "PARTITION BY")
+}
+
+// splitPartitions Helper function. Splits the body of the partition list into the
+// individual partition definitions
+func splitPartitions(body string) (definitions []string) {
+	depth := 0
+	inQuote := false
+	start := -1
+
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\'':
+			inQuote = !inQuote
+		case '(':
+			if !inQuote {
+				depth++
+			}
+		case ')':
+			if !inQuote {
+				depth--
+			}
+		}
+
+		if depth == 0 && !inQuote && strings.HasPrefix(body[i:], "PARTITION ") && (i == 0 || body[i-1] == ' ' || body[i-1] == ',') {
+			if start != -1 {
+				definitions = append(definitions, strings.Trim(body[start:i], " ,"))
+			}
+			start = i
+		}
+	}
+
+	if start != -1 {
+		definitions = append(definitions, strings.Trim(body[start:], " ,"))
+	}
+	return definitions
+}
+
+// buildPartition Parses a single partition definition
+func buildPartition(definition string) (partition table.Partition, err error) {
+	// Format: PARTITION <NAME> [VALUES LESS THAN (<VALUES>)|MAXVALUE] [VALUES IN (<VALUES>)] [ENGINE = <ENGINE>]
+
+	fields := strings.Fields(definition)
+	if len(fields) < 2 {
+		return partition, parseError(fmt.Sprintf("Invalid Partition Definition: No name defined: [%s]", definition))
+	}
+	partition.Name = strings.Trim(fields[1], "`")
+
+	for _, clause := range []string{"VALUES LESS THAN ", "VALUES IN "} {
+		pos := strings.Index(definition, clause)
+		if pos == -1 {
+			continue
+		}
+
+		values := strings.TrimSpace(definition[pos+len(clause):])
+
+		if strings.HasPrefix(values, "(") {
+			end := closingBracket(values, 0)
+			if end == -1 {
+				return partition, parseError(fmt.Sprintf("Invalid Partition Definition: Malformed VALUES: [%s]", definition))
+			}
+			partition.Values = values[:end+1]
+		} else {
+			partition.Values = strings.Fields(values)[0]
+		}
+	}
+
+	return partition, err
+}
+
+// buildPartitioning Parses the PARTITION BY definition which follows the table options
+func buildPartitioning(definition string) (partitioning table.Partitioning, err error) {
+	// Format: PARTITION BY [LINEAR] <TYPE> [COLUMNS](<EXPRESSION>) [PARTITIONS <COUNT>] [(<PARTITION>, ...)]
+
+	def := definition
+	for _, cruft := range []string{"/*!50100", "/*!50500", "*/"} {
+		def = strings.Replace(def, cruft, "", -1)
+	}
+	def = strings.TrimSpace(def)
+
+	if !strings.HasPrefix(def, "PARTITION BY") {
+		return partitioning, parseError(fmt.Sprintf("Invalid Partitioning Definition: Missing PARTITION BY: [%s]", definition))
+	}
+	def = strings.TrimSpace(strings.TrimPrefix(def, "PARTITION BY"))
+
+	if strings.HasPrefix(def, "LINEAR ") {
+		partitioning.Linear = true
+		def = strings.TrimSpace(strings.TrimPrefix(def, "LINEAR "))
+	}
+
+	for _, ptype := range []string{table.PartitionRange, table.PartitionList, table.PartitionHash, table.PartitionKey} {
+		if strings.HasPrefix(def, ptype) {
+			partitioning.Type = ptype
+			def = strings.TrimSpace(strings.TrimPrefix(def, ptype))
+			break
+		}
+	}
+
+	if !partitioning.IsValid() {
+		return partitioning, parseError(fmt.Sprintf("Invalid Partitioning Definition: Unsupported type: [%s]", definition))
+	}
+
+	if strings.HasPrefix(def, "COLUMNS") {
+		partitioning.Columns = true
+		def = strings.TrimSpace(strings.TrimPrefix(def, "COLUMNS"))
+	}
+
+	// Extract the partitioning expression
+	end := closingBracket(def, 0)
+	if !strings.HasPrefix(def, "(") || end == -1 {
+		return partitioning, parseError(fmt.Sprintf("Invalid Partitioning Definition: Malformed expression: [%s]", definition))
+	}
+	partitioning.Expression = strings.TrimSpace(def[1:end])
+	def = strings.TrimSpace(def[end+1:])
+
+	if strings.HasPrefix(def, "PARTITIONS ") {
+		count := strings.Fields(def)[1]
+		partitioning.Count, err = strconv.Atoi(count)
+		if err != nil {
+			return partitioning, parseError(fmt.Sprintf("Invalid Partitioning Definition: Malformed PARTITIONS count: [%s]", definition))
+		}
+		def = strings.TrimSpace(strings.TrimPrefix(def, "PARTITIONS "+count))
+	}
+
+	// Extract the partition definitions
+	if strings.HasPrefix(def, "(") {
+		end = closingBracket(def, 0)
+		if end == -1 {
+			return partitioning, parseError(fmt.Sprintf("Invalid Partitioning Definition: Malformed partition list: [%s]", definition))
+		}
+
+		var partition table.Partition
+		for _, partDef := range splitPartitions(def[1:end]) {
+			partition, err = buildPartition(partDef)
+			if err != nil {
+				return partitioning, err
+			}
+			partitioning.Partitions = append(partitioning.Partitions, partition)
+		}
+	}
+
+	return partitioning, err
+}
+
 func parseError(msg string) error {
 	return fmt.Errorf("Parse Error MySQL CREATE TABLE: %s", msg)
 }
@@ -152,6 +468,7 @@ func buildTable(lines []string, tbl *table.Table) (err error) {
 	var charset string
 	var rowFormat string
 	var collation string
+	var comment string
 
 	// var hasMetadata bool
 	var md metadata.Metadata
@@ -177,6 +494,13 @@ func buildTable(lines []string, tbl *table.Table) (err error) {
 	// trim the cruft of the front of the line
 	lastLine = strings.TrimLeft(lastLine, ") ")
 
+	// extract COMMENT first as it may contain any of the other parameters
+	comment, lastLine, err = extractComment(" " + lastLine)
+	if err != nil {
+		return err
+	}
+	lastLine = strings.TrimSpace(lastLine)
+
 	if hasParameter(lastLine, ENGINE) {
 		// extract ENGINE and value
 		engine, err = extractParameter(lastLine, ENGINE)
@@ -235,6 +559,7 @@ func buildTable(lines []string, tbl *table.Table) (err error) {
 	tbl.CharSet = charset
 	tbl.RowFormat = rowFormat
 	tbl.Collation = collation
+	tbl.Comment = comment
 	tbl.Filename = "DB"
 
 	return err
@@ -248,6 +573,30 @@ func buildColumn(line string, tblPropertyID string, tblName string) (column tabl
 	// Trim whitespace from the ends of the statement
 	line = strings.TrimSpace(line)
 
+	// Extract the COMMENT first as it may contain any of the other clauses
+	comment, line, err := extractComment(line)
+	if err != nil {
+		return column, err
+	}
+
+	// Extract any generated column expression as it may contain any of the other clauses
+	expression, storage, line, err := extractGenerated(line)
+	if err != nil {
+		return column, err
+	}
+
+	// Extract any ENUM or SET members as they may contain whitespace and brackets
+	values, line, err := extractValues(line)
+	if err != nil {
+		return column, err
+	}
+
+	// Extract the SRID of spatial columns as it's wrapped in a version comment
+	srid, line, err := extractSRID(line)
+	if err != nil {
+		return column, err
+	}
+
 	// Split on whitespace.
 	// This will result in:
 	// [0] Name
@@ -346,6 +695,7 @@ func buildColumn(line string, tblPropertyID string, tblName string) (column tabl
 	autoinc := false
 	defaultValue := ""
 	collationValue := ""
+	charsetValue := ""
 	updateValue := ""
 
 	// If unsigned is present
@@ -438,6 +788,21 @@ func buildColumn(line string, tblPropertyID string, tblName string) (column tabl
 		}
 	}
 
+	// if CHARACTER SET is present
+	charsetPos := strings.Index(parameters, CHARACTER_SET)
+	if charsetPos != -1 {
+		// Now extract value of the parameter from the original line (non-ToUpper())
+		lineEnd := line[paramOffset+charsetPos+len(CHARACTER_SET):]
+		charsetStr := strings.TrimSpace(lineEnd)
+
+		cCmp := strings.Split(charsetStr, " ")
+		if len(cCmp) > 0 && cCmp[0] != "" {
+			charsetValue = cCmp[0]
+		} else {
+			return column, parseError(fmt.Sprintf("Invalid Column Definition: Couldn't extract CHARACTER SET: [%s]", line))
+		}
+	}
+
 	// if ON UPDATE is present
 	updatePos := strings.Index(parameters, ON_UPDATE)
 	if updatePos != -1 {
@@ -464,12 +829,18 @@ func buildColumn(line string, tblPropertyID string, tblName string) (column tabl
 	column.Name = name
 	column.Type = datatype
 	column.Size = colSizes
+	column.Values = values
 	column.Unsigned = unsigned
 	column.Default = defaultValue
 	column.Nullable = nullable
 	column.AutoInc = autoinc
+	column.CharSet = charsetValue
 	column.Collation = collationValue
 	column.OnUpdate = updateValue
+	column.Comment = comment
+	column.Expression = expression
+	column.Storage = storage
+	column.SRID = srid
 
 	md.Name = column.Name
 	md.Type = "Column"
@@ -512,6 +883,36 @@ func buildIndexColumns(key string) (indexColumns []table.IndexColumn, err error)
 	return
 }
 
+// extractIndexOptions Removes the index type and parser options from the key parameter.
+// Returns the key without the options, the index type, and the name of the parser.
+func extractIndexOptions(key string) (remainder string, using string, parser string) {
+	remainder = key
+
+	// Versioned comments are used by MySQL to wrap the WITH PARSER option
+	for _, cruft := range []string{"/*!50100", "*/"} {
+		remainder = strings.Replace(remainder, cruft, "", -1)
+	}
+
+	// USING may appear before or after the columns
+	if pos := strings.Index(remainder, " USING "); pos != -1 {
+		option := strings.Fields(remainder[pos+len(" USING "):])
+		if len(option) > 0 {
+			using = strings.Trim(option[0], "(")
+			remainder = remainder[:pos] + strings.TrimPrefix(remainder[pos+len(" USING "):], using)
+		}
+	}
+
+	if pos := strings.Index(remainder, " WITH PARSER "); pos != -1 {
+		option := strings.Fields(remainder[pos+len(" WITH PARSER "):])
+		if len(option) > 0 {
+			parser = strings.Trim(option[0], "`")
+			remainder = remainder[:pos] + strings.TrimPrefix(strings.TrimSpace(remainder[pos+len(" WITH PARSER "):]), option[0])
+		}
+	}
+
+	return strings.TrimSpace(remainder), using, parser
+}
+
 func buildPrimaryKey(pk string, tblPropertyID string, tblName string) (primaryKey table.Index, err error) {
 
 	var md metadata.Metadata
@@ -527,6 +928,7 @@ func buildPrimaryKey(pk string, tblPropertyID string, tblName string) (primaryKe
 	}
 
 	pk = strings.TrimPrefix(pk, "PRIMARY KEY")
+	pk, primaryKey.Using, _ = extractIndexOptions(pk)
 
 	primaryKey.Columns, err = buildIndexColumns(pk)
 	primaryKey.IsPrimary = true
@@ -543,12 +945,22 @@ func buildPrimaryKey(pk string, tblPropertyID string, tblName string) (primaryKe
 
 }
 
+// isIndexDefinition Helper function. Does the line define a secondary index
+func isIndexDefinition(line string) bool {
+	for _, prefix := range []string{"KEY", "UNIQUE KEY", "FULLTEXT KEY", "SPATIAL KEY"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func buildIndex(key string, tblPropertyID string, tblName string) (index table.Index, err error) {
-	// Format: [UNIQUE] KEY `<NAME>` (`<COLUMN_1>`[(<size)],`<COLUMN_2>`[(<size)])
+	// Format: [UNIQUE|FULLTEXT|SPATIAL] KEY `<NAME>` (`<COLUMN_1>`[(<size)],`<COLUMN_2>`[(<size)]) [USING <TYPE>] [WITH PARSER <PARSER>]
 
 	var md metadata.Metadata
 
-	if !strings.HasPrefix(key, "KEY") && !strings.HasPrefix(key, "UNIQUE KEY") {
+	if !isIndexDefinition(key) {
 		return index, parseError(fmt.Sprintf("Invalid Index Definition: Invalid KEY type: [%s]", key))
 	}
 
@@ -556,8 +968,18 @@ func buildIndex(key string, tblPropertyID string, tblName string) (index table.I
 		index.IsUnique = true
 		// Remove UNIQUE Prefix
 		key = strings.TrimLeft(key, "UNIQUE ")
+
+	} else if strings.HasPrefix(key, "FULLTEXT KEY") {
+		index.Kind = table.IndexFullText
+		key = strings.TrimPrefix(key, "FULLTEXT ")
+
+	} else if strings.HasPrefix(key, "SPATIAL KEY") {
+		index.Kind = table.IndexSpatial
+		key = strings.TrimPrefix(key, "SPATIAL ")
 	}
 
+	key, index.Using, index.Parser = extractIndexOptions(key)
+
 	// Remove KEY Prefix
 	key = strings.TrimLeft(key, "KEY ")
 
@@ -581,6 +1003,144 @@ func buildIndex(key string, tblPropertyID string, tblName string) (index table.I
 	return index, err
 }
 
+// parseColumnNames Helper function. Extracts a list of `quoted` column names from between brackets
+func parseColumnNames(columnsStr string) (columns []string) {
+	for _, col := range strings.Split(columnsStr, ",") {
+		col = strings.Trim(strings.TrimSpace(col), "`")
+		if len(col) > 0 {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// parseReferenceAction Helper function. Extracts the referential action following the clause parameter
+func parseReferenceAction(parameters string, clause string) (action string) {
+	pos := strings.Index(parameters, clause)
+	if pos == -1 {
+		return action
+	}
+
+	actionStr := strings.TrimSpace(parameters[pos+len(clause):])
+
+	for _, candidate := range []string{"RESTRICT", "CASCADE", "SET NULL", "NO ACTION", "SET DEFAULT"} {
+		if strings.HasPrefix(actionStr, candidate) {
+			return table.NormalizeReferenceAction(candidate)
+		}
+	}
+	return action
+}
+
+func buildForeignKey(constraint string, tblPropertyID string, tblName string) (fk table.ForeignKey, err error) {
+	// Format: CONSTRAINT `<NAME>` FOREIGN KEY (`<COLUMN_1>`,...) REFERENCES `<TABLE>` (`<COLUMN_1>`,...) [ON DELETE <ACTION>] [ON UPDATE <ACTION>]
+
+	var md metadata.Metadata
+
+	constraint = strings.TrimSpace(constraint)
+
+	if !strings.HasPrefix(constraint, "CONSTRAINT") {
+		return fk, parseError(fmt.Sprintf("Invalid Foreign Key Definition: Invalid CONSTRAINT type: [%s]", constraint))
+	}
+
+	fkPos := strings.Index(constraint, "FOREIGN KEY")
+	refPos := strings.Index(constraint, "REFERENCES")
+
+	if fkPos == -1 || refPos == -1 || refPos < fkPos {
+		return fk, parseError(fmt.Sprintf("Invalid Foreign Key Definition: Missing FOREIGN KEY or REFERENCES clause: [%s]", constraint))
+	}
+
+	// Extract Name, stripping whitespace and backticks
+	fk.Name = strings.Trim(constraint[len("CONSTRAINT"):fkPos], " `")
+
+	if len(fk.Name) == 0 {
+		return fk, parseError(fmt.Sprintf("Invalid Foreign Key Definition: No name defined: [%s]", constraint))
+	}
+
+	// Extract the constrained columns
+	localStr := constraint[fkPos+len("FOREIGN KEY") : refPos]
+	lb := strings.Index(localStr, "(")
+	rb := strings.LastIndex(localStr, ")")
+	if lb == -1 || rb < lb {
+		return fk, parseError(fmt.Sprintf("Invalid Foreign Key Definition: No columns defined: [%s]", constraint))
+	}
+	fk.Columns = parseColumnNames(localStr[lb+1 : rb])
+
+	// Extract the referenced table and columns
+	refStr := constraint[refPos+len("REFERENCES"):]
+	lb = strings.Index(refStr, "(")
+	rb = strings.Index(refStr, ")")
+	if lb == -1 || rb < lb {
+		return fk, parseError(fmt.Sprintf("Invalid Foreign Key Definition: No reference columns defined: [%s]", constraint))
+	}
+	fk.ReferenceTable = strings.Trim(refStr[:lb], " `")
+	fk.ReferenceColumns = parseColumnNames(refStr[lb+1 : rb])
+
+	if !fk.IsValid() {
+		return fk, parseError(fmt.Sprintf("Invalid Foreign Key Definition: Incomplete reference: [%s]", constraint))
+	}
+
+	// Extract any referential actions
+	actions := strings.ToUpper(refStr[rb+1:])
+	fk.OnDelete = parseReferenceAction(actions, "ON DELETE")
+	fk.OnUpdate = parseReferenceAction(actions, ON_UPDATE)
+
+	md.PropertyID = fk.Name
+	md.ParentID = tblPropertyID
+	md.Name = fk.Name
+	md.Type = "ForeignKey"
+	md.Exists = true
+	fk.Metadata = md
+
+	return fk, err
+}
+
+func buildCheckConstraint(constraint string, tblPropertyID string, tblName string) (chk table.CheckConstraint, err error) {
+	// Format: CONSTRAINT `<NAME>` CHECK (<EXPRESSION>) [/*!80016 NOT ENFORCED */]
+
+	var md metadata.Metadata
+
+	constraint = strings.TrimSpace(constraint)
+
+	if !strings.HasPrefix(constraint, "CONSTRAINT") {
+		return chk, parseError(fmt.Sprintf("Invalid Check Constraint Definition: Invalid CONSTRAINT type: [%s]", constraint))
+	}
+
+	checkPos := strings.Index(constraint, " CHECK (")
+	if checkPos == -1 {
+		return chk, parseError(fmt.Sprintf("Invalid Check Constraint Definition: Missing CHECK clause: [%s]", constraint))
+	}
+
+	// Extract Name, stripping whitespace and backticks
+	chk.Name = strings.Trim(constraint[len("CONSTRAINT"):checkPos], " `")
+
+	if len(chk.Name) == 0 {
+		return chk, parseError(fmt.Sprintf("Invalid Check Constraint Definition: No name defined: [%s]", constraint))
+	}
+
+	// Extract the expression from within the CHECK brackets
+	lb := checkPos + len(" CHECK ")
+	rb := closingBracket(constraint, lb)
+	if rb == -1 {
+		return chk, parseError(fmt.Sprintf("Invalid Check Constraint Definition: Unterminated expression: [%s]", constraint))
+	}
+	chk.Expression = strings.TrimSpace(constraint[lb+1 : rb])
+
+	if !chk.IsValid() {
+		return chk, parseError(fmt.Sprintf("Invalid Check Constraint Definition: No expression defined: [%s]", constraint))
+	}
+
+	chk.NotEnforced = strings.Contains(strings.ToUpper(constraint[rb+1:]), "NOT ENFORCED")
+
+	md.PropertyID = chk.Name
+	md.ParentID = tblPropertyID
+	md.Name = chk.Name
+	md.Type = "CheckConstraint"
+	md.Exists = true
+	chk.Metadata = md
+
+	return chk, err
+}
+
 // ParseCreateTable Parses a MySQL Create Table statement into a table.Table struct
 func ParseCreateTable(createTable string) (tbl table.Table, err error) {
 
@@ -597,6 +1157,16 @@ func ParseCreateTable(createTable string) (tbl table.Table, err error) {
 		lines[i] = strings.TrimRight(lines[i], ";")
 	}
 
+	// Separate any partitioning definition from the table definition
+	var partitioning []string
+	for i, line := range lines {
+		if isPartitionDefinition(line) {
+			partitioning = lines[i:]
+			lines = lines[:i]
+			break
+		}
+	}
+
 	err = buildTable(lines, &tbl)
 
 	// This code will make some assumptions regarding the create table
@@ -604,6 +1174,8 @@ func ParseCreateTable(createTable string) (tbl table.Table, err error) {
 	var pk string
 	var column []string
 	var secondaryKeys []string
+	var foreignKeys []string
+	var checks []string
 
 	// Process the lines into the appropriate categories
 	for _, line := range lines {
@@ -611,9 +1183,15 @@ func ParseCreateTable(createTable string) (tbl table.Table, err error) {
 		if strings.HasPrefix(line, "PRIMARY KEY") {
 			pk = line
 
-		} else if strings.HasPrefix(line, "KEY") || strings.HasPrefix(line, "UNIQUE KEY") {
+		} else if isIndexDefinition(line) {
 			secondaryKeys = append(secondaryKeys, line)
 
+		} else if strings.HasPrefix(line, "CONSTRAINT") && strings.Contains(line, "FOREIGN KEY") {
+			foreignKeys = append(foreignKeys, line)
+
+		} else if strings.HasPrefix(line, "CONSTRAINT") && strings.Contains(line, " CHECK (") {
+			checks = append(checks, line)
+
 		} else if strings.HasPrefix(line, "`") {
 			column = append(column, line)
 		}
@@ -653,13 +1231,229 @@ func ParseCreateTable(createTable string) (tbl table.Table, err error) {
 
 	}
 
+	// extract any FOREIGN KEY constraints
+	var fk table.ForeignKey
+	for _, constraint := range foreignKeys {
+		fk, err = buildForeignKey(constraint, tbl.Metadata.PropertyID, tbl.Name)
+		if !util.ErrorCheckf(err, "Failed to parse Foreign Key from CREATE TABLE") {
+			tbl.ForeignKeys = append(tbl.ForeignKeys, fk)
+		} else {
+			return tbl, err
+		}
+	}
+
+	// extract any CHECK constraints
+	var chk table.CheckConstraint
+	for _, constraint := range checks {
+		chk, err = buildCheckConstraint(constraint, tbl.Metadata.PropertyID, tbl.Name)
+		if !util.ErrorCheckf(err, "Failed to parse Check Constraint from CREATE TABLE") {
+			tbl.CheckConstraints = append(tbl.CheckConstraints, chk)
+		} else {
+			return tbl, err
+		}
+	}
+
+	// extract any PARTITION BY definition
+	if len(partitioning) > 0 {
+		tbl.Partitioning, err = buildPartitioning(strings.Join(partitioning, " "))
+		if util.ErrorCheckf(err, "Failed to parse Partitioning from CREATE TABLE") {
+			return tbl, err
+		}
+	}
+
 	// Retrieve any Metadata from the Management DB
 	err = tbl.LoadDBMetadata()
 
 	return tbl, err
 }
 
-func ReadTableNames() (tables []string, err error) {
+// ParseCreateView Parses a MySQL Create View statement into a table.View struct
+func ParseCreateView(createView string) (view table.View, err error) {
+	// Format: CREATE [ALGORITHM=<ALG>] [DEFINER=<USER>] [SQL SECURITY <SEC>] VIEW `<NAME>` AS <SELECT> [WITH <OPT> CHECK OPTION]
+
+	var md metadata.Metadata
+
+	createView = strings.TrimRight(strings.TrimSpace(createView), ";")
+
+	if !strings.HasPrefix(createView, "CREATE") {
+		return view, parseError(fmt.Sprintf("Invalid View Definition: Not a CREATE statement: [%s]", createView))
+	}
+
+	viewPos := strings.Index(createView, " VIEW `")
+	if viewPos == -1 {
+		return view, parseError(fmt.Sprintf("Invalid View Definition: Missing VIEW name: [%s]", createView))
+	}
+	header := createView[:viewPos]
+
+	nameStart := viewPos + len(" VIEW `")
+	nameEnd := strings.Index(createView[nameStart:], "`")
+	if nameEnd < 1 {
+		return view, parseError(fmt.Sprintf("Invalid View Definition: Unable to parse View Name: [%s]", createView))
+	}
+	view.Name = createView[nameStart : nameStart+nameEnd]
+
+	definition := createView[nameStart+nameEnd+1:]
+	if !strings.HasPrefix(definition, " AS ") {
+		return view, parseError(fmt.Sprintf("Invalid View Definition: Missing AS clause: [%s]", createView))
+	}
+	definition = strings.TrimSpace(definition[len(" AS "):])
+
+	// extract any CHECK OPTION from the end of the definition
+	for _, option := range []string{"CASCADED", "LOCAL"} {
+		clause := fmt.Sprintf(" WITH %s CHECK OPTION", option)
+		if strings.HasSuffix(definition, clause) {
+			view.CheckOption = option
+			definition = strings.TrimSuffix(definition, clause)
+		}
+	}
+	view.Definition = definition
+
+	if len(view.Definition) == 0 {
+		return view, parseError(fmt.Sprintf("Invalid View Definition: No SELECT defined: [%s]", createView))
+	}
+
+	if pos := strings.Index(header, "ALGORITHM="); pos != -1 {
+		view.Algorithm = strings.Fields(header[pos+len("ALGORITHM="):])[0]
+	}
+
+	if pos := strings.Index(header, "SQL SECURITY "); pos != -1 {
+		view.Security = strings.Fields(header[pos+len("SQL SECURITY "):])[0]
+	}
+
+	md.Name = view.Name
+	md.Type = "View"
+	md.Exists = true
+	view.Metadata = md
+	view.Filename = "DB"
+
+	// Retrieve any Metadata from the Management DB
+	err = view.LoadDBMetadata()
+
+	return view, err
+}
+
+// ParseCreateEvent Parses a MySQL Create Event statement into a table.Event struct
+func ParseCreateEvent(createEvent string) (event table.Event, err error) {
+	// Format: CREATE [DEFINER=<USER>] EVENT `<NAME>` ON SCHEDULE <SCHEDULE> [ON COMPLETION [NOT] PRESERVE]
+	//         [ENABLE | DISABLE | DISABLE ON SLAVE] [COMMENT '<COMMENT>'] DO <BODY>
+
+	var md metadata.Metadata
+
+	createEvent = strings.TrimRight(strings.TrimSpace(createEvent), ";")
+
+	if !strings.HasPrefix(createEvent, "CREATE") {
+		return event, parseError(fmt.Sprintf("Invalid Event Definition: Not a CREATE statement: [%s]", createEvent))
+	}
+
+	eventPos := strings.Index(createEvent, " EVENT `")
+	if eventPos == -1 {
+		return event, parseError(fmt.Sprintf("Invalid Event Definition: Missing EVENT name: [%s]", createEvent))
+	}
+
+	nameStart := eventPos + len(" EVENT `")
+	nameEnd := strings.Index(createEvent[nameStart:], "`")
+	if nameEnd < 1 {
+		return event, parseError(fmt.Sprintf("Invalid Event Definition: Unable to parse Event Name: [%s]", createEvent))
+	}
+	event.Name = createEvent[nameStart : nameStart+nameEnd]
+
+	header := strings.TrimSpace(createEvent[nameStart+nameEnd+1:])
+	if !strings.HasPrefix(header, "ON SCHEDULE ") {
+		return event, parseError(fmt.Sprintf("Invalid Event Definition: Missing ON SCHEDULE clause: [%s]", createEvent))
+	}
+
+	// The body follows the first DO outside of the quoted schedule times and comment
+	doPos := indexUnquotedKeyword(header, "DO")
+	if doPos == -1 {
+		return event, parseError(fmt.Sprintf("Invalid Event Definition: Missing DO clause: [%s]", createEvent))
+	}
+	event.Body = strings.TrimSpace(header[doPos+len("DO"):])
+	header = strings.TrimSpace(header[:doPos])
+
+	// extract any COMMENT from the end of the header
+	if commentPos := indexUnquotedKeyword(header, "COMMENT"); commentPos != -1 {
+		comment := strings.TrimSpace(header[commentPos+len("COMMENT"):])
+		event.Comment = strings.Replace(strings.Trim(comment, "'"), "''", "'", -1)
+		header = strings.TrimSpace(header[:commentPos])
+	}
+
+	for _, status := range []string{table.EventStatusDisableOnSlave, table.EventStatusDisable, table.EventStatusEnable} {
+		if strings.HasSuffix(header, " "+status) {
+			event.Status = status
+			header = strings.TrimSpace(strings.TrimSuffix(header, status))
+			break
+		}
+	}
+
+	for _, completion := range []string{table.EventNotPreserve, table.EventPreserve} {
+		if strings.HasSuffix(header, " ON COMPLETION "+completion) {
+			event.OnCompletion = completion
+			header = strings.TrimSpace(strings.TrimSuffix(header, "ON COMPLETION "+completion))
+			break
+		}
+	}
+
+	event.Schedule = strings.TrimSpace(strings.TrimPrefix(header, "ON SCHEDULE "))
+
+	md.Name = event.Name
+	md.Type = "Event"
+	md.Exists = true
+	event.Metadata = md
+	event.Filename = "DB"
+
+	// Retrieve any Metadata from the Management DB
+	err = event.LoadDBMetadata()
+
+	return event, err
+}
+
+// indexUnquotedKeyword Returns the index of the first whitespace delimited keyword
+// in statement which isn't inside of a quoted string, or -1 if it isn't present
+func indexUnquotedKeyword(statement string, keyword string) int {
+	quoted := false
+
+	isSpace := func(pos int) bool {
+		return pos < 0 || pos >= len(statement) || strings.ContainsRune(" \t\r\n", rune(statement[pos]))
+	}
+
+	for i := 0; i < len(statement); i++ {
+		if statement[i] == '\'' {
+			quoted = !quoted
+			continue
+		}
+		if !quoted && strings.HasPrefix(statement[i:], keyword) && isSpace(i-1) && isSpace(i+len(keyword)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseCreateRoutine Parses a MySQL Create Trigger, Procedure or Function statement into a table.Routine struct
+func ParseCreateRoutine(createRoutine string) (routine table.Routine, err error) {
+
+	var md metadata.Metadata
+
+	routine.Definition = createRoutine
+
+	err = routine.ParseDefinition()
+	if err != nil {
+		return routine, parseError(err.Error())
+	}
+
+	md.Name = routine.Name
+	md.Type = routine.MetadataType()
+	md.Exists = true
+	routine.Metadata = md
+	routine.Filename = "DB"
+
+	// Retrieve any Metadata from the Management DB
+	err = routine.LoadDBMetadata()
+
+	return routine, err
+}
+
+// ReadSchemaNames Reads the names of the tables and the views in the project database
+func ReadSchemaNames() (tables []string, views []string, err error) {
 	var rows *sql.Rows
 	var pdb *sql.DB
 
@@ -669,34 +1463,120 @@ func ReadTableNames() (tables []string, err error) {
 	// Ensure that the connection is cleaned up
 	// defer pdb.Close()
 	if util.ErrorCheckf(err, "Problem opening connection to target database") {
-		return tables, err
+		return tables, views, err
 	}
 
 	// If the Database connection exists
 	if pdb != nil {
-		rows, err = pdb.Query("show tables")
+		rows, err = pdb.Query("show full tables")
 
 		if util.ErrorCheckf(err, "Problem retrieving tables") {
-			return tables, err
+			return tables, views, err
 		}
 
 		defer rows.Close()
 
 		for rows.Next() {
 			var name string
-			err = rows.Scan(&name)
+			var tableType string
+			err = rows.Scan(&name, &tableType)
 			if util.ErrorCheckf(err, "Could not parse name from database tables") {
-				return tables, err
+				return tables, views, err
+			}
+
+			// Views are listed alongside the tables and are read separately
+			if tableType == "VIEW" {
+				views = append(views, name)
+			} else {
+				tables = append(tables, name)
+			}
+		}
+	}
+
+	return tables, views, err
+}
+
+// ReadRoutineNames Reads the names and types of the triggers, procedures and functions in the project database
+func ReadRoutineNames() (routines []table.Routine, err error) {
+	var rows *sql.Rows
+	var pdb *sql.DB
+
+	// Connect to the Project database
+	pdb, err = connectProjectDB()
+
+	if util.ErrorCheckf(err, "Problem opening connection to target database") {
+		return routines, err
+	}
+
+	// If the Database connection exists
+	if pdb != nil {
+		rows, err = pdb.Query(ROUTINE_NAMES)
+
+		if util.ErrorCheckf(err, "Problem retrieving routines") {
+			return routines, err
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var routine table.Routine
+			err = rows.Scan(&routine.Name, &routine.Type)
+			if util.ErrorCheckf(err, "Could not parse name from database routines") {
+				return routines, err
+			}
+			routines = append(routines, routine)
+		}
+	}
+
+	return routines, err
+}
+
+// ReadEventNames Reads the names of the events in the project database
+func ReadEventNames() (events []string, err error) {
+	var rows *sql.Rows
+	var pdb *sql.DB
+
+	// Connect to the Project database
+	pdb, err = connectProjectDB()
+
+	if util.ErrorCheckf(err, "Problem opening connection to target database") {
+		return events, err
+	}
+
+	// If the Database connection exists
+	if pdb != nil {
+		rows, err = pdb.Query(EVENT_NAMES)
+
+		if util.ErrorCheckf(err, "Problem retrieving events") {
+			return events, err
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var name string
+			err = rows.Scan(&name)
+			if util.ErrorCheckf(err, "Could not parse name from database events") {
+				return events, err
 			}
-			tables = append(tables, name)
+			events = append(events, name)
 		}
 	}
 
+	return events, err
+}
+
+// ReadTableNames Reads the names of the tables in the project database, excluding any views
+func ReadTableNames() (tables []string, err error) {
+	tables, _, err = ReadSchemaNames()
 	return tables, err
 }
 
 // ReadTables Reads the database for the project parameter and parses the
-// show create table result for each into table.Table structs
+// show create table result for each into table.Table structs, the show
+// create view result for each view into table.View structs, and the show create
+// result for each trigger, procedure and function into table.Routine structs and
+// the show create event result for each event into table.Event structs
 func ReadTables(conf config.Config) (err error) {
 
 	type CreateTable struct {
@@ -708,7 +1588,13 @@ func ReadTables(conf config.Config) (err error) {
 	var pdb *sql.DB
 	var tables []CreateTable
 	var tableNames []string
+	var viewNames []string
+	var routineNames []table.Routine
 	var tbl table.Table
+	var view table.View
+	var routine table.Routine
+	var eventNames []string
+	var event table.Event
 
 	// Connect to the Project database
 	pdb, err = connectProjectDB()
@@ -720,7 +1606,20 @@ func ReadTables(conf config.Config) (err error) {
 
 	// If the Database connection exists
 	if pdb != nil {
-		tableNames, err = ReadTableNames()
+		tableNames, viewNames, err = ReadSchemaNames()
+		if err != nil {
+			return err
+		}
+
+		routineNames, err = ReadRoutineNames()
+		if err != nil {
+			return err
+		}
+
+		eventNames, err = ReadEventNames()
+		if err != nil {
+			return err
+		}
 
 		for _, tableName := range tableNames {
 			tables = append(tables, CreateTable{tableName, ""})
@@ -755,11 +1654,118 @@ func ReadTables(conf config.Config) (err error) {
 			tbl.SetNamespace(conf)
 			Schema = append(Schema, tbl)
 		}
+
+		// Extract and process the Create View Statements
+		for _, viewName := range viewNames {
+			var create string
+
+			rows, err = pdb.Query("show create view " + viewName)
+			if util.ErrorCheckf(err, "Could not execute show create view for: %s", viewName) {
+				return err
+			}
+
+			for rows.Next() {
+				var name string
+				var charset string
+				var collation string
+
+				err = rows.Scan(&name, &create, &charset, &collation)
+				if util.ErrorCheck(err) {
+					return err
+				}
+			}
+
+			view, err = ParseCreateView(create)
+			if err != nil {
+				return err
+			}
+			Views = append(Views, view)
+		}
+
+		// Extract and process the Create Trigger, Procedure and Function Statements
+		for _, rn := range routineNames {
+			var create string
+
+			// The statement is the third column of the result
+			create, err = readShowCreate(pdb, rn.Type, rn.Name, 2)
+			if err != nil {
+				return err
+			}
+
+			routine, err = ParseCreateRoutine(create)
+			if err != nil {
+				return err
+			}
+			Routines = append(Routines, routine)
+		}
+
+		// Extract and process the Create Event Statements
+		for _, eventName := range eventNames {
+			var create string
+
+			// The statement is the fourth column of the result
+			create, err = readShowCreate(pdb, "EVENT", eventName, 3)
+			if err != nil {
+				return err
+			}
+
+			event, err = ParseCreateEvent(create)
+			if err != nil {
+				return err
+			}
+			event.SetNamespace(conf)
+			Events = append(Events, event)
+		}
 	}
 
 	return err
 }
 
+// readShowCreate Read the CREATE statement from the column of the SHOW CREATE result
+// for the object with the objectType and name parameters
+func readShowCreate(pdb *sql.DB, objectType string, name string, column int) (create string, err error) {
+	var rows *sql.Rows
+	var columns []string
+	var statement sql.NullString
+
+	objectType = strings.ToLower(objectType)
+
+	rows, err = pdb.Query(fmt.Sprintf("show create %s %s", objectType, name))
+	if util.ErrorCheckf(err, "Could not execute show create %s for: %s", objectType, name) {
+		return create, err
+	}
+	defer rows.Close()
+
+	columns, err = rows.Columns()
+	if util.ErrorCheck(err) {
+		return create, err
+	}
+
+	if len(columns) <= column {
+		return create, fmt.Errorf("Unexpected result from show create %s for: %s", objectType, name)
+	}
+
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		for i := range values {
+			values[i] = new(sql.RawBytes)
+		}
+		values[column] = &statement
+
+		err = rows.Scan(values...)
+		if util.ErrorCheck(err) {
+			return create, err
+		}
+	}
+
+	// The statement is NULL when the user lacks the privileges to read it
+	if !statement.Valid {
+		return create, fmt.Errorf("Unable to read the definition of %s: [%s]. Check the privileges of the database user", objectType, name)
+	}
+
+	return statement.String, err
+}
+
 // ReadDump Read a MySQL Dump file as a source of MySQL Schema and return the
 // CREATE TABLE statements as an array of strings
 func ReadDump(filename string) (statements []string, err error) {
@@ -780,7 +1786,7 @@ func ReadDump(filename string) (statements []string, err error) {
 
 	for _, line := range lines {
 		// Ignore comments
-		if len(line) == 0 || strings.HasPrefix(line, "--") || strings.HasPrefix(line, "/*") || strings.HasPrefix(line, "DROP") {
+		if len(line) == 0 || strings.HasPrefix(line, "--") || (strings.HasPrefix(line, "/*") && !isPartitionDefinition(line)) || strings.HasPrefix(line, "DROP") {
 			continue
 		}
 
@@ -10,6 +10,15 @@ import (
 // Schema MySQL Table Schema
 var Schema table.Tables
 
+// Views MySQL View Schema
+var Views table.Views
+
+// Routines MySQL Trigger, Procedure and Function Schema
+var Routines table.Routines
+
+// Events MySQL Event Schema
+var Events table.Events
+
 var projectDB *sql.DB
 var projectDBConn string
 
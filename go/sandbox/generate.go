@@ -31,6 +31,20 @@ func underscoreDelimit(input string) string {
 	return pattern.ReplaceAllString(input, "${1}_$2")
 }
 
+// docComment Formats a table or column comment as a block of line comments using
+// the prefix parameter e.g. {{docComment "// " .Comment}}
+func docComment(prefix string, comment string) string {
+	if len(comment) == 0 {
+		return ""
+	}
+
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(prefix+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
 func scanGeneratedFiles(path string) (files []string, err error) {
 
 	visit := func(path string, f os.FileInfo, err error) error {
@@ -90,6 +104,7 @@ func writeTables(conf config.Config, tables []table.Table) (err error) {
 	// Define the custom template functions
 	funcMap := template.FuncMap{
 		"content":           contentSlice,
+		"docComment":        docComment,
 		"removeHungarian":   removeHungarian,
 		"toUpper":           strings.ToUpper,
 		"trimSuffix":        strings.TrimSuffix,
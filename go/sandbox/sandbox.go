@@ -83,6 +83,9 @@ func diffSchema(conf config.Config, actionTitle string, recreate bool) (forwardO
 
 	var forwardDiff table.Differences
 	var backwardDiff table.Differences
+	var viewDiff table.Differences
+	var routineDiff table.Differences
+	var eventDiff table.Differences
 	// Read the YAML schema
 	err = yaml.ReadTables(conf)
 	if util.ErrorCheck(err) {
@@ -130,6 +133,24 @@ func diffSchema(conf config.Config, actionTitle string, recreate bool) (forwardO
 		if util.ErrorCheckf(err, "Diff Failed while generating forward migration") {
 			return forwardOps, backwardOps, err
 		}
+		viewDiff, err = table.DiffViews(yaml.Views, mysql.Views, false, true)
+		if util.ErrorCheckf(err, "View Diff Failed while generating forward migration") {
+			return forwardOps, backwardOps, err
+		}
+		// Views are applied after the tables they select from
+		forwardDiff.Merge(viewDiff)
+		routineDiff, err = table.DiffRoutines(yaml.Routines, mysql.Routines, false, true)
+		if util.ErrorCheckf(err, "Routine Diff Failed while generating forward migration") {
+			return forwardOps, backwardOps, err
+		}
+		// Routines are applied after the tables and views they reference
+		forwardDiff.Merge(routineDiff)
+		eventDiff, err = table.DiffEvents(yaml.Events, mysql.Events, false, true)
+		if util.ErrorCheckf(err, "Event Diff Failed while generating forward migration") {
+			return forwardOps, backwardOps, err
+		}
+		// Events are applied after the objects they may reference
+		forwardDiff.Merge(eventDiff)
 		forwardOps = mysql.GenerateAlters(forwardDiff)
 
 		util.LogMagenta("Generating Backward Diff  <<")
@@ -137,6 +158,21 @@ func diffSchema(conf config.Config, actionTitle string, recreate bool) (forwardO
 		if util.ErrorCheckf(err, "Diff Failed while generating backward migration") {
 			return forwardOps, backwardOps, err
 		}
+		viewDiff, err = table.DiffViews(yaml.Views, mysql.Views, false, false)
+		if util.ErrorCheckf(err, "View Diff Failed while generating backward migration") {
+			return forwardOps, backwardOps, err
+		}
+		backwardDiff.Merge(viewDiff)
+		routineDiff, err = table.DiffRoutines(yaml.Routines, mysql.Routines, false, false)
+		if util.ErrorCheckf(err, "Routine Diff Failed while generating backward migration") {
+			return forwardOps, backwardOps, err
+		}
+		backwardDiff.Merge(routineDiff)
+		eventDiff, err = table.DiffEvents(yaml.Events, mysql.Events, false, false)
+		if util.ErrorCheckf(err, "Event Diff Failed while generating backward migration") {
+			return forwardOps, backwardOps, err
+		}
+		backwardDiff.Merge(eventDiff)
 
 		backwardOps = mysql.GenerateAlters(backwardDiff)
 	}
@@ -160,6 +196,9 @@ func createMigration(conf config.Config, actionTitle string, dryrun bool, forwar
 			Backwards:   backwardOps,
 			Sandbox:     true,
 			VettedBy:    "sandbox",
+			Algorithm:   conf.Project.Migration.Algorithm,
+			// Coalesce the operations as create does by default, so that the sandbox tests the same statements
+			Coalesce: true,
 		})
 
 		if util.ErrorCheck(err) {
@@ -178,11 +217,76 @@ func createMigration(conf config.Config, actionTitle string, dryrun bool, forwar
 func recreateProjectDatabase(conf config.Config, dryrun bool) (err error) {
 	var output string
 	var tables []string
+	var views []string
+	var routines []table.Routine
+	var events []string
+
+	tables, views, err = mysql.ReadSchemaNames()
+	if util.ErrorCheckf(err, "Problem reading the tables and views for Project: [%s]", conf.Project.Name) {
+		return fmt.Errorf("Sandbox Recreation failed. Couldn't read the Project Database schema")
+	}
+
+	routines, err = mysql.ReadRoutineNames()
+	if util.ErrorCheckf(err, "Problem reading the routines for Project: [%s]", conf.Project.Name) {
+		return fmt.Errorf("Sandbox Recreation failed. Couldn't read the Project Database routines")
+	}
 
-	tables, err = mysql.ReadTableNames()
+	events, err = mysql.ReadEventNames()
+	if util.ErrorCheckf(err, "Problem reading the events for Project: [%s]", conf.Project.Name) {
+		return fmt.Errorf("Sandbox Recreation failed. Couldn't read the Project Database events")
+	}
 
 	util.LogInfo(formatMessage(dryrun, "Sandbox Recreation", "Recreating Database"))
 
+	// Triggers, Procedures and Functions are not removed by dropping the tables
+	for _, routine := range routines {
+		dropRoutine := routine.DropSQL()
+
+		if !dryrun {
+			output, err = exec.ExecuteSQL(dropRoutine, false)
+			if util.ErrorCheckf(err, "Problem dropping %s for Project: [%s] SQL: [%s] Output: [%s]", routine.Type, conf.Project.Name, dropRoutine, output) {
+				return fmt.Errorf("Sandbox Recreation failed. Couldn't DROP %s: [%s] for Project Database", routine.Type, routine.Name)
+			}
+		} else {
+			util.LogInfof("(DRYRUN) Exec SQL: %s", dropRoutine)
+		}
+	}
+	if !dryrun {
+		mysql.Routines = []table.Routine{}
+	}
+
+	// Events are not removed by dropping the tables
+	for _, eventName := range events {
+		dropEvent := table.Event{Name: eventName}.DropSQL()
+
+		if !dryrun {
+			output, err = exec.ExecuteSQL(dropEvent, false)
+			if util.ErrorCheckf(err, "Problem dropping EVENT for Project: [%s] SQL: [%s] Output: [%s]", conf.Project.Name, dropEvent, output) {
+				return fmt.Errorf("Sandbox Recreation failed. Couldn't DROP EVENT: [%s] for Project Database", eventName)
+			}
+		} else {
+			util.LogInfof("(DRYRUN) Exec SQL: %s", dropEvent)
+		}
+	}
+	if !dryrun {
+		mysql.Events = []table.Event{}
+	}
+
+	// Views are dropped ahead of the tables they select from
+	if len(views) > 0 {
+		dropViews := fmt.Sprintf("DROP VIEW `%s`", strings.Join(views, "`,`"))
+
+		if !dryrun {
+			output, err = exec.ExecuteSQL(dropViews, false)
+			if util.ErrorCheckf(err, "Problem dropping ALL VIEWS for Project: [%s] SQL: [%s] Output: [%s]", conf.Project.Name, dropViews, output) {
+				return fmt.Errorf("Sandbox Recreation failed. Couldn't DROP ALL VIEWS for Project Database")
+			}
+			mysql.Views = []table.View{}
+		} else {
+			util.LogInfof("(DRYRUN) Exec SQL: %s", dropViews)
+		}
+	}
+
 	if len(tables) > 0 {
 		dropTables := fmt.Sprintf("DROP TABLE `%s`", strings.Join(tables, "`,`"))
 
@@ -68,6 +68,7 @@ func TestDiffSchema(t *testing.T) {
 			Op:        table.Add,
 			Name:      "address",
 			Metadata:  expectedAddressMetadata,
+			Algorithm: mysql.AlgorithmInstant,
 		},
 	}
 
@@ -77,6 +78,7 @@ func TestDiffSchema(t *testing.T) {
 			Op:        table.Del,
 			Name:      "address",
 			Metadata:  expectedAddressMetadata,
+			Algorithm: mysql.AlgorithmInplace,
 		},
 	}
 
@@ -262,6 +264,9 @@ func TestCreateMigration(t *testing.T) {
 			testName,
 			0,
 			"sandbox",
+			"",
+			"",
+			0,
 		},
 		1,
 		1,
@@ -280,6 +285,15 @@ func TestCreateMigration(t *testing.T) {
 			"",
 			0,
 			"sandbox",
+			false,
+			"",
+			"",
+			"",
+			0,
+			"",
+			"",
+			0,
+			"dogs",
 		},
 		1,
 		1,
@@ -338,7 +352,7 @@ func TestRecreateProjectDatabase(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDb.Db, 1)
-		exec.Setup(mgmtDb.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDb.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDb.Db, 1)
 		metadata.Setup(mgmtDb.Db, 1)
 	}
@@ -416,7 +430,7 @@ func TestMigrateSandbox(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDb.Db, 1)
-		exec.Setup(mgmtDb.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDb.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDb.Db, 1)
 		metadata.Setup(mgmtDb.Db, 1)
 	}
@@ -451,6 +465,15 @@ func TestMigrateSandbox(t *testing.T) {
 		step.Output,
 		migration.InProgress,
 		"sandbox",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -473,6 +496,15 @@ func TestMigrateSandbox(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.ForcedCI,
 		"sandbox",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -503,6 +535,9 @@ func TestMigrateSandbox(t *testing.T) {
 		m.VersionDescription,
 		migration.ForcedCI,
 		"sandbox",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		m.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -517,6 +552,15 @@ func TestMigrateSandbox(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.ForcedCI,
 		"sandbox",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -581,6 +625,8 @@ func TestRefreshDatabase(t *testing.T) {
 				Backward: "DROP TABLE `dogs`;",
 				Output:   "",
 				Status:   migration.Unapproved,
+
+				TableName: "dogs",
 			},
 		},
 		Sandbox: true,
@@ -637,7 +683,7 @@ func TestRefreshDatabase(t *testing.T) {
 
 	if err == nil {
 		// migration.Setup(mgmtDb.Db, 1)
-		exec.Setup(mgmtDb.Db, 1, testConfig.Project.DB.ConnectString())
+		exec.Setup(mgmtDb.Db, 1, testConfig.Project)
 		migration.Setup(mgmtDb.Db, 1)
 		metadata.Setup(mgmtDb.Db, 1)
 	}
@@ -693,6 +739,9 @@ func TestRefreshDatabase(t *testing.T) {
 			testName,
 			0,
 			"sandbox",
+			"",
+			"",
+			0,
 		},
 		1,
 		1,
@@ -711,6 +760,15 @@ func TestRefreshDatabase(t *testing.T) {
 			"",
 			0,
 			"sandbox",
+			false,
+			"",
+			"",
+			"",
+			0,
+			"",
+			"",
+			0,
+			"dogs",
 		},
 		1,
 		1,
@@ -745,6 +803,15 @@ func TestRefreshDatabase(t *testing.T) {
 		step.Output,
 		migration.InProgress,
 		"sandbox",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -767,6 +834,15 @@ func TestRefreshDatabase(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.ForcedCI,
 		"sandbox",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -789,6 +865,9 @@ func TestRefreshDatabase(t *testing.T) {
 		1,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Mark all of the Table's fields as existing
+	mgmtDb.MetadataSetTableExists(md.Name, 1)
+
 	// Update Migration with completed
 	mgmtDb.Mock.ExpectExec("update `migration`").WithArgs(
 		m.DB,
@@ -798,6 +877,9 @@ func TestRefreshDatabase(t *testing.T) {
 		m.VersionDescription,
 		migration.ForcedCI,
 		"sandbox",
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
 		m.MID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -812,6 +894,15 @@ func TestRefreshDatabase(t *testing.T) {
 		"Row(s) Affected: 1",
 		migration.ForcedCI,
 		"sandbox",
+		step.Safe,
+		step.Operations,
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		step.TableName,
 		step.SID,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
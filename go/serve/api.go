@@ -34,6 +34,10 @@ func setupServer(apiConfig config.Config) (err error) {
 
 	conf = apiConfig
 
+	if len(conf.Project.Approval.Policies) > 0 && conf.Project.Approval.IdentityHeader == "" {
+		util.LogWarn("No approval identityheader is configured.  Approvals are recorded against the unauthenticated vetted_by of each request")
+	}
+
 	// Put Metadata into Cache Mode, otherwise the server is going to be quite slow.
 	// This is not super necessary as the server doesn't need to handle high RPS.
 	metadata.UseCache(true)
@@ -78,6 +82,7 @@ func Run(apiConfig config.Config, frontend bool, port int) (err error) {
 	registerDatabaseEndpoints(r)
 	registerTableEndpoints(r)
 	registerSandboxEndpoints(r)
+	registerLockEndpoints(r)
 	registerHealthEndpoints(r)
 
 	// Serve the Javascript Frontend UI as well
@@ -114,6 +119,21 @@ func writeResponse(w http.ResponseWriter, body interface{}, e error) (err error)
 }
 
 // writeErrorResponse Helper function for building a standardised JSON error response
+// authenticatedUser Returns the user authenticated by the proxy in front of the API, read
+// from the configured identity header
+func authenticatedUser(r *http.Request) (user string, err error) {
+	header := conf.Project.Approval.IdentityHeader
+	if header == "" {
+		return user, fmt.Errorf("No approval identityheader is configured to authenticate the request")
+	}
+
+	user = strings.TrimSpace(r.Header.Get(header))
+	if user == "" {
+		return user, fmt.Errorf("No authenticated user in header: [%s]", header)
+	}
+	return user, err
+}
+
 func writeErrorResponse(w http.ResponseWriter, r *http.Request, detail string, e error, errorData interface{}) (err error) {
 	var response []byte
 	var mt []byte
@@ -0,0 +1,64 @@
+package serve
+
+import (
+	"net/http"
+
+	"github.com/freneticmonkey/migrate/go/lock"
+	"github.com/freneticmonkey/migrate/go/util"
+	"github.com/gorilla/mux"
+)
+
+type lockStatus struct {
+	Held bool      `json:"held"`
+	Lock lock.Lock `json:"lock"`
+}
+
+// registerLockEndpoints Register the migration lock functions for the REST API
+func registerLockEndpoints(r *mux.Router) {
+	r.HandleFunc("/api/lock/", getLock).Methods("GET")
+	r.HandleFunc("/api/lock/release/", releaseLock).Methods("POST")
+}
+
+// getLock Return the process holding the Migration Lock of the project database
+func getLock(w http.ResponseWriter, r *http.Request) {
+	verboseLogging(r)
+
+	current, held, err := lock.Status()
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, "Unable to read the Migration Lock", err, nil)
+		return
+	}
+	writeResponse(w, lockStatus{Held: held, Lock: current}, err)
+}
+
+// releaseLock Release the expired Migration Lock of the project database.  The Lock is
+// only released while it's held by a running process if the force parameter is true.
+// The request must be made by a user authenticated by the configured identity header.
+func releaseLock(w http.ResponseWriter, r *http.Request) {
+	verboseLogging(r)
+
+	user, err := authenticatedUser(r)
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, "Unable to release the Migration Lock", err, nil)
+		return
+	}
+
+	force := r.URL.Query().Get("force") == "true"
+
+	released, err := lock.Release(force)
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, "Unable to release the Migration Lock", err, nil)
+		return
+	}
+
+	if released {
+		if force {
+			util.LogWarnf("Migration Lock: [%s] forcibly released by: [%s]", lock.Name(), user)
+		} else {
+			util.LogInfof("Migration Lock: [%s] released by: [%s]", lock.Name(), user)
+		}
+	}
+	writeResponse(w, released, err)
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/freneticmonkey/migrate/go/approval"
+	"github.com/freneticmonkey/migrate/go/exec"
 	"github.com/freneticmonkey/migrate/go/migration"
 	"github.com/freneticmonkey/migrate/go/util"
 	"github.com/gorilla/mux"
@@ -21,6 +23,8 @@ type migrationList struct {
 // registerMigrationEndpoints Register the migration functions for the REST API
 func registerMigrationEndpoints(r *mux.Router) {
 	r.HandleFunc("/api/migration/{id}", getMigration)
+	r.HandleFunc("/api/migration/{id}/plan/", getMigrationPlan)
+	r.HandleFunc("/api/migration/{id}/approval/", getMigrationApproval)
 	r.HandleFunc("/api/migration/version/{version}", getMigrationByVersion)
 	r.HandleFunc("/api/migration/list/", listMigrations)
 	r.HandleFunc("/api/migration/list/{start}", listMigrations)
@@ -47,6 +51,53 @@ func getMigration(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, m, err)
 }
 
+// getMigrationPlan Get the annotated Steps of the Migration for review
+func getMigrationPlan(w http.ResponseWriter, r *http.Request) {
+	verboseLogging(r)
+	vars := mux.Vars(r)
+	id, err := strconv.ParseInt(vars["id"], 10, 64)
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, fmt.Sprintf("Invalid Migration Id: %d", id), err, nil)
+		return
+	}
+
+	plan, err := exec.NewPlan(id, false)
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, fmt.Sprintf("Unable to plan Migration Id: %d", id), err, nil)
+		return
+	}
+	writeResponse(w, plan, err)
+}
+
+// getMigrationApproval Get the evaluation of the approval policy of the environment for the Migration
+func getMigrationApproval(w http.ResponseWriter, r *http.Request) {
+	verboseLogging(r)
+	vars := mux.Vars(r)
+	id, err := strconv.ParseInt(vars["id"], 10, 64)
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, fmt.Sprintf("Invalid Migration Id: %d", id), err, nil)
+		return
+	}
+
+	m, err := migration.Load(id)
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, fmt.Sprintf("Unable to load Migration Id: %d", id), err, nil)
+		return
+	}
+
+	evaluation, err := approval.Evaluate(m, conf.Project.DB.Environment, conf.Project.Approval)
+
+	if util.ErrorCheck(err) {
+		writeErrorResponse(w, r, fmt.Sprintf("Unable to evaluate the approval policy of Migration Id: %d", id), err, nil)
+		return
+	}
+	writeResponse(w, evaluation, err)
+}
+
 // getMigrationByVersion Get Migration by Git Version
 func getMigrationByVersion(w http.ResponseWriter, r *http.Request) {
 	var err error
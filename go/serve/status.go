@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/freneticmonkey/migrate/go/approval"
 	"github.com/freneticmonkey/migrate/go/migration"
 	"github.com/freneticmonkey/migrate/go/util"
 	"github.com/gorilla/mux"
@@ -24,6 +26,7 @@ type editStatus struct {
 	Migrations []migrationStatus `json:"migrations"`
 	Steps      []stepStatus      `json:"steps"`
 	VettedBy   string            `json:"vetted_by"`
+	Comment    string            `json:"comment"`
 }
 
 // registerStatusEndpoints Register the migration functions for the REST API
@@ -51,9 +54,10 @@ func setStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify that a valid string has been supplied for the vetter
-	if status.VettedBy == "" {
-		writeErrorResponse(w, r, fmt.Sprintf("Unable to update status.  No vetter supplied"), nil, nil)
+	// Approvals are tied to the user authenticated by the proxy in front of the API when it is configured
+	status.VettedBy, err = vetter(r, status.VettedBy)
+	if err != nil {
+		writeErrorResponse(w, r, fmt.Sprintf("Unable to update status"), err, nil)
 		return
 	}
 
@@ -96,17 +100,51 @@ func setStatus(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Update the migration steps with the sent status for each of the steps and store to the database
+	// Update the migrations with the sent status and store to the database.  Approvals are recorded, and the
+	// migration is only Approved once the approval policy of the environment is satisfied.
 	for _, migrationStatus := range status.Migrations {
-		for _, migration := range migrations {
-			if migration.MID == migrationStatus.MID {
-				migration.Status = migrationStatus.Status
-				migration.VettedBy = status.VettedBy
+		for _, m := range migrations {
+			if m.MID == migrationStatus.MID {
+				m.Status = migrationStatus.Status
+				m.VettedBy = status.VettedBy
+
+				if migrationStatus.Status == migration.Approved {
+					var evaluation approval.Evaluation
+
+					_, err = approval.Approve(&m, status.VettedBy, status.Comment, conf.Project.DB.Environment, conf.Project.Approval)
+					if util.ErrorCheck(err) {
+						writeErrorResponse(w, r, fmt.Sprintf("Unable to approve Migration with ID: %d", m.MID), err, nil)
+						return
+					}
+
+					// The Steps of the Migration determine whether a DBA needs to approve it
+					var full *migration.Migration
+					full, err = migration.Load(m.MID)
+					if util.ErrorCheck(err) {
+						writeErrorResponse(w, r, fmt.Sprintf("Unable to load Migration with ID: %d", m.MID), err, nil)
+						return
+					}
+
+					evaluation, err = approval.Evaluate(full, conf.Project.DB.Environment, conf.Project.Approval)
+					if util.ErrorCheck(err) {
+						writeErrorResponse(w, r, fmt.Sprintf("Unable to evaluate the approval policy of Migration with ID: %d", m.MID), err, nil)
+						return
+					}
+
+					// Wait for the remaining approvals
+					if !evaluation.Satisfied {
+						util.LogInfof("Migration: [%d] approved by: [%s].  Awaiting approvals: %s", m.MID, status.VettedBy, strings.Join(evaluation.Reasons, ", "))
+						continue
+					}
+					if evaluation.Policy {
+						m.VettedBy = strings.Join(evaluation.Approvers, ", ")
+					}
+				}
 
-				err = migration.Update()
+				err = m.Update()
 
 				if util.ErrorCheck(err) {
-					writeErrorResponse(w, r, fmt.Sprintf("Unable to update Migration with ID: %d", migration.MID), err, nil)
+					writeErrorResponse(w, r, fmt.Sprintf("Unable to update Migration with ID: %d", m.MID), err, nil)
 					return
 				}
 			}
@@ -115,3 +153,26 @@ func setStatus(w http.ResponseWriter, r *http.Request) {
 
 	writeResponse(w, "ok", err)
 }
+
+// vetter Returns the identity of the user updating the status.  If an identity header is
+// configured, the user authenticated by the proxy is used and a conflicting vetted_by is
+// refused.  Otherwise vetted_by is trusted as supplied.
+func vetter(r *http.Request, vettedBy string) (identity string, err error) {
+	vettedBy = strings.TrimSpace(vettedBy)
+
+	if conf.Project.Approval.IdentityHeader == "" {
+		if vettedBy == "" {
+			return identity, fmt.Errorf("No vetter supplied")
+		}
+		return vettedBy, err
+	}
+
+	identity, err = authenticatedUser(r)
+	if err != nil {
+		return identity, err
+	}
+	if vettedBy != "" && !strings.EqualFold(vettedBy, identity) {
+		return "", fmt.Errorf("Vetter: [%s] doesn't match the authenticated user: [%s]", vettedBy, identity)
+	}
+	return identity, err
+}
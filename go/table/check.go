@@ -0,0 +1,48 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// CheckConstraint Stores the properties for a CHECK constraint
+type CheckConstraint struct {
+	ID          string `yaml:"id"`
+	Name        string
+	Expression  string
+	NotEnforced bool              `yaml:",omitempty"`
+	Metadata    metadata.Metadata `yaml:"-"`
+}
+
+// IsValid Return if the check constraint has an expression
+func (c CheckConstraint) IsValid() bool {
+	return len(c.Name) > 0 && len(c.Expression) > 0
+}
+
+// ReferencedColumns Returns the names of the columns used by the check expression
+func (c CheckConstraint) ReferencedColumns() []string {
+	return expressionColumns(c.Expression)
+}
+
+// UsesColumn Returns true if the column parameter is used by the check expression
+func (c CheckConstraint) UsesColumn(column string) bool {
+	return util.StringInArray(column, c.ReferencedColumns())
+}
+
+// ToSQL Formats the check constraint into its SQL representation
+func (c CheckConstraint) ToSQL() string {
+
+	if !c.IsValid() {
+		return ""
+	}
+
+	sql := fmt.Sprintf("CONSTRAINT `%s` CHECK (%s)", c.Name, c.Expression)
+
+	if c.NotEnforced {
+		sql += " /*!80016 NOT ENFORCED */"
+	}
+
+	return sql
+}
@@ -2,23 +2,74 @@ package table
 
 import (
 	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/freneticmonkey/migrate/go/metadata"
 	"github.com/freneticmonkey/migrate/go/util"
 )
 
+// Generated Column storage types
+const (
+	ColumnVirtual = "VIRTUAL"
+	ColumnStored  = "STORED"
+)
+
+// integerRange The range of values which can be stored by an integer type
+type integerRange struct {
+	min int64
+	max uint64
+}
+
+// integerRanges The signed and unsigned ranges of each of the MySQL integer types
+var integerRanges = map[string][2]integerRange{
+	"tinyint":   {{math.MinInt8, math.MaxInt8}, {0, math.MaxUint8}},
+	"smallint":  {{math.MinInt16, math.MaxInt16}, {0, math.MaxUint16}},
+	"mediumint": {{-8388608, 8388607}, {0, 16777215}},
+	"int":       {{math.MinInt32, math.MaxInt32}, {0, math.MaxUint32}},
+	"integer":   {{math.MinInt32, math.MaxInt32}, {0, math.MaxUint32}},
+	"bigint":    {{math.MinInt64, math.MaxInt64}, {0, math.MaxUint64}},
+}
+
+// lobCapacities The maximum length in bytes of the TEXT and BLOB types
+var lobCapacities = map[string]uint64{
+	"tinytext":   255,
+	"text":       65535,
+	"mediumtext": 16777215,
+	"longtext":   4294967295,
+	"tinyblob":   255,
+	"blob":       65535,
+	"mediumblob": 16777215,
+	"longblob":   4294967295,
+}
+
+// identifierPattern Matches quoted identifiers, string literals and bare words within an expression
+var identifierPattern = regexp.MustCompile("`([^`]+)`|'(?:[^']|'')*'|([A-Za-z_$][A-Za-z0-9_$]*)\\s*(\\()?")
+
 // Column Stores the properties for a Column field
 type Column struct {
 	ID        string `yaml:"id"`
 	Name      string
 	Type      string
-	Size      []int  `yaml:",flow"`
-	Default   string `yaml:",omitempty"`
-	Nullable  bool   `yaml:",omitempty"`
-	AutoInc   bool   `yaml:",omitempty"`
-	Unsigned  bool   `yaml:",omitempty"`
-	Collation string `yaml:",omitempty"`
-	OnUpdate  string `yaml:",omitempty"`
+	Size      []int    `yaml:",flow"`
+	Values    []string `yaml:",flow,omitempty"`
+	CharSet   string   `yaml:",omitempty"`
+	Default   string   `yaml:",omitempty"`
+	Nullable  bool     `yaml:",omitempty"`
+	AutoInc   bool     `yaml:",omitempty"`
+	Unsigned  bool     `yaml:",omitempty"`
+	Collation string   `yaml:",omitempty"`
+	OnUpdate  string   `yaml:",omitempty"`
+	Comment   string   `yaml:",omitempty"`
+
+	// Generated Columns
+	Expression string `yaml:",omitempty"`
+	Storage    string `yaml:",omitempty"`
+
+	// Spatial Reference System of a spatial column
+	SRID int `yaml:",omitempty"`
 
 	// Binary      bool
 	// Unique      bool
@@ -36,10 +87,18 @@ func (c Column) ToSQL() string {
 		params.Add("unsigned")
 	}
 
+	if c.IsGenerated() {
+		params.Add(c.GeneratedSQL())
+	}
+
 	if !c.Nullable {
 		params.Add("NOT NULL")
 	}
 
+	if c.SRID > 0 {
+		params.Add(c.SRIDSQL())
+	}
+
 	if c.AutoInc {
 		params.Add("AUTO_INCREMENT")
 	}
@@ -61,19 +120,247 @@ func (c Column) ToSQL() string {
 		params.Add(fmt.Sprintf("ON UPDATE %s", c.OnUpdate))
 	}
 
-	size := ""
-
-	switch len(c.Size) {
-	case 1:
-		size = fmt.Sprintf("(%d)", c.Size[0])
-	case 2:
-		size = fmt.Sprintf("(%d,%d)", c.Size[0], c.Size[1])
-	case 0:
-		size = ""
+	if len(c.Comment) > 0 {
+		params.Add(fmt.Sprintf("COMMENT %s", util.QuoteSQLString(c.Comment)))
 	}
-	sql := fmt.Sprintf("`%s` %s%s", c.Name, c.Type, size)
+
+	sql := fmt.Sprintf("`%s` %s", c.Name, c.TypeSQL())
 	if len(params.Values) > 0 {
 		sql += fmt.Sprintf(" %s", params.String())
 	}
 	return sql
 }
+
+// TypeSQL Formats the column datatype, including its size or ENUM and SET members
+// and any CHARACTER SET, into its SQL representation
+func (c Column) TypeSQL() string {
+	sql := c.Type
+
+	switch {
+	case len(c.Values) > 0:
+		values := []string{}
+		for _, value := range c.Values {
+			values = append(values, util.QuoteSQLString(value))
+		}
+		sql += fmt.Sprintf("(%s)", strings.Join(values, ","))
+	case len(c.Size) == 1:
+		sql += fmt.Sprintf("(%d)", c.Size[0])
+	case len(c.Size) == 2:
+		sql += fmt.Sprintf("(%d,%d)", c.Size[0], c.Size[1])
+	}
+
+	if len(c.CharSet) > 0 {
+		sql += fmt.Sprintf(" CHARACTER SET %s", c.CharSet)
+	}
+
+	return sql
+}
+
+// SRIDSQL Formats the SRID attribute in the version comment used by MySQL 8.0.3+
+func (c Column) SRIDSQL() string {
+	return fmt.Sprintf("/*!80003 SRID %d */", c.SRID)
+}
+
+// AppendsValues Returns true if the only difference from the from parameter is
+// that additional ENUM or SET members have been appended to the existing members
+func (c Column) AppendsValues(from Column) bool {
+	if len(c.Values) <= len(from.Values) {
+		return false
+	}
+
+	for i, value := range from.Values {
+		if c.Values[i] != value {
+			return false
+		}
+	}
+
+	// All of the other properties of the column must be unchanged
+	to := c
+	to.Values = from.Values
+	to.Metadata = from.Metadata
+
+	return reflect.DeepEqual(to, from)
+}
+
+// DataLossConditions Returns the SQL conditions which select the rows that would lose
+// data if the column was changed from the from parameter, such as values which are too
+// long for a narrower VARCHAR or out of the range of a smaller integer type.  No
+// conditions are returned if the change can't lose data, or it can't be checked.
+func (c Column) DataLossConditions(from Column) (conditions []string) {
+	name := fmt.Sprintf("`%s`", from.Name)
+
+	// Strings which are longer than the new length are truncated
+	if toCapacity, length, ok := c.stringCapacity(); ok {
+		if fromCapacity, _, ok := from.stringCapacity(); ok && fromCapacity > toCapacity {
+			conditions = append(conditions, fmt.Sprintf("%s(%s) > %d", length, name, toCapacity))
+		}
+	}
+
+	// Integers outside of the new range are clipped, including negative values of a
+	// column which becomes unsigned
+	if toRange, ok := c.integerRange(); ok {
+		if fromRange, ok := from.integerRange(); ok {
+			if fromRange.min < toRange.min {
+				conditions = append(conditions, fmt.Sprintf("%s < %d", name, toRange.min))
+			}
+			if fromRange.max > toRange.max {
+				conditions = append(conditions, fmt.Sprintf("%s > %d", name, toRange.max))
+			}
+		}
+	}
+
+	// Decimals lose their fractional digits or are clipped to fewer integer digits
+	if toPrecision, toScale, ok := c.decimalSize(); ok {
+		if fromPrecision, fromScale, ok := from.decimalSize(); ok {
+			if toScale < fromScale {
+				conditions = append(conditions, fmt.Sprintf("%s <> ROUND(%s, %d)", name, name, toScale))
+			}
+			if toPrecision-toScale < fromPrecision-fromScale {
+				conditions = append(conditions, fmt.Sprintf("ABS(%s) >= POW(10, %d)", name, toPrecision-toScale))
+			}
+		}
+	}
+
+	// ENUM and SET members which are removed can no longer be stored
+	if c.Type == from.Type && (c.Type == "enum" || c.Type == "set") {
+		members := map[string]bool{}
+		for _, value := range c.Values {
+			members[value] = true
+		}
+
+		removed := []string{}
+		for _, value := range from.Values {
+			if !members[value] {
+				removed = append(removed, value)
+			}
+		}
+
+		if len(removed) > 0 {
+			if c.Type == "enum" {
+				values := []string{}
+				for _, value := range removed {
+					values = append(values, util.QuoteSQLString(value))
+				}
+				conditions = append(conditions, fmt.Sprintf("%s IN (%s)", name, strings.Join(values, ",")))
+			} else {
+				for _, value := range removed {
+					conditions = append(conditions, fmt.Sprintf("FIND_IN_SET(%s, %s) > 0", util.QuoteSQLString(value), name))
+				}
+			}
+		}
+	}
+
+	// NULL values are converted to the implicit default of a NOT NULL column
+	if from.Nullable && !c.Nullable {
+		conditions = append(conditions, fmt.Sprintf("%s IS NULL", name))
+	}
+
+	return conditions
+}
+
+// stringCapacity Returns the maximum length of a string column and the SQL function
+// which measures it.  ok is false if the column doesn't store strings.
+func (c Column) stringCapacity() (capacity uint64, length string, ok bool) {
+	switch c.Type {
+	case "char", "varchar":
+		length = "CHAR_LENGTH"
+	case "binary", "varbinary":
+		length = "LENGTH"
+	default:
+		capacity, ok = lobCapacities[c.Type]
+		return capacity, "LENGTH", ok
+	}
+
+	// CHAR and BINARY columns default to a length of 1
+	capacity = 1
+	if len(c.Size) > 0 {
+		capacity = uint64(c.Size[0])
+	}
+	return capacity, length, true
+}
+
+// integerRange Returns the range of values which can be stored by an integer column.
+// ok is false if the column isn't an integer type.
+func (c Column) integerRange() (r integerRange, ok bool) {
+	ranges, ok := integerRanges[c.Type]
+	if c.Unsigned {
+		return ranges[1], ok
+	}
+	return ranges[0], ok
+}
+
+// decimalSize Returns the precision and scale of a DECIMAL column.  ok is false if
+// the column isn't a DECIMAL type.
+func (c Column) decimalSize() (precision int, scale int, ok bool) {
+	if c.Type != "decimal" && c.Type != "numeric" {
+		return precision, scale, false
+	}
+
+	// The precision defaults to 10 and the scale to 0
+	precision = 10
+	if len(c.Size) > 0 {
+		precision = c.Size[0]
+	}
+	if len(c.Size) > 1 {
+		scale = c.Size[1]
+	}
+	return precision, scale, true
+}
+
+// RenamesOnly Returns true if the only difference from the from parameter is the name of the column
+func (c Column) RenamesOnly(from Column) bool {
+	to := c
+	to.Name = from.Name
+	to.Metadata = from.Metadata
+
+	return reflect.DeepEqual(to, from)
+}
+
+// IsGenerated Returns true if the column value is generated from an expression
+func (c Column) IsGenerated() bool {
+	return len(c.Expression) > 0
+}
+
+// GeneratedSQL Formats the generated column expression and storage type into its SQL representation
+func (c Column) GeneratedSQL() string {
+	storage := c.Storage
+	if len(storage) == 0 {
+		storage = ColumnVirtual
+	}
+	return fmt.Sprintf("GENERATED ALWAYS AS (%s) %s", c.Expression, storage)
+}
+
+// ReferencedColumns Returns the names of the columns used by the generated column expression.
+// Function names and string literals are ignored.
+func (c Column) ReferencedColumns() (columns []string) {
+	if !c.IsGenerated() {
+		return columns
+	}
+	return expressionColumns(c.Expression)
+}
+
+// expressionColumns Returns the names of the columns used by an SQL expression
+func expressionColumns(expression string) (columns []string) {
+	for _, match := range identifierPattern.FindAllStringSubmatch(expression, -1) {
+		name := match[1]
+
+		// Bare words which aren't function calls or keywords
+		if len(name) == 0 && len(match[2]) > 0 && len(match[3]) == 0 {
+			if !util.StringInArray(strings.ToUpper(match[2]), expressionKeywords) {
+				name = match[2]
+			}
+		}
+
+		if len(name) > 0 && !util.StringInArray(name, columns) {
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+// expressionKeywords SQL keywords which may appear as bare words in an expression
+var expressionKeywords = []string{
+	"AND", "OR", "NOT", "XOR", "IS", "NULL", "TRUE", "FALSE", "LIKE", "IN", "BETWEEN",
+	"CASE", "WHEN", "THEN", "ELSE", "END", "DIV", "MOD", "AS", "INTERVAL", "BINARY",
+	"DAY", "MONTH", "YEAR", "HOUR", "MINUTE", "SECOND", "WEEK", "QUARTER",
+}
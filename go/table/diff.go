@@ -85,6 +85,9 @@ func (d *Differences) Merge(diffs Differences) {
 //
 // A simple recursive loop over ( Table->Columns)
 //                              (      ->Indexes)
+//                              (      ->ForeignKeys)
+//                              (      ->CheckConstraints)
+//                              (      ->Partitions)
 //
 // to determine if there have been any changes between the 'from' (existing) db table(s) and the 'to' (new) db table(s)
 //
@@ -263,16 +266,39 @@ func diffColumns(toTable Table, fromTable Table) (hasDiff bool, differences Diff
 	}
 
 	// Column Properties
-	fieldNames := []string{"Name", "Type", "Size", "Nullable", "AutoInc", "Default", "Collation"}
+	fieldNames := []string{"Name", "Type", "Size", "Values", "Unsigned", "Nullable", "AutoInc", "Default", "CharSet", "Collation", "Comment", "Expression", "Storage", "SRID"}
 	if differentColumns := diffProperties(toTable.Name, "Columns", fieldNames, toColumns, fromColumns); len(differentColumns.Slice) > 0 {
 		hasDiff = true
 
-		differences.Merge(differentColumns)
+		differences.Merge(coalesceColumnRenames(differentColumns))
 	}
 
 	return hasDiff, differences
 }
 
+// coalesceColumnRenames Reduces the field differences of each renamed column to
+// its Name difference.  The CHANGE COLUMN statement generated for a rename contains
+// the complete column definition so it applies the other field differences as well.
+// Any further MODIFY COLUMN would reference the old column name and fail.
+func coalesceColumnRenames(columnDiffs Differences) (differences Differences) {
+	renamed := []string{}
+
+	for _, diff := range columnDiffs.Slice {
+		if diff.Op == Mod && diff.Property == "Name" {
+			renamed = append(renamed, diff.Metadata.PropertyID)
+		}
+	}
+
+	for _, diff := range columnDiffs.Slice {
+		if diff.Op == Mod && diff.Property != "Name" && util.StringInArray(diff.Metadata.PropertyID, renamed) {
+			continue
+		}
+		differences.Add(diff)
+	}
+
+	return differences
+}
+
 func diffIndexColumns(toIndex Index, fromIndex Index, fromTable Table, indexName string) (hasDiff bool, differences Differences) {
 
 	if !reflect.DeepEqual(toIndex.Columns, fromIndex.Columns) {
@@ -386,22 +412,237 @@ func diffIndexes(toTable Table, fromTable Table) (hasDiff bool, differences Diff
 	}
 
 	// Index Properties
-	fieldNames = []string{"Name", "Columns", "IsPrimary", "IsUnique"}
+	fieldNames = []string{"Name", "Columns", "IsPrimary", "IsUnique", "Kind", "Using", "Parser"}
 
 	if differentIndexes := diffProperties(toTable.Name, "SecondaryIndexes", fieldNames, toIndexes, fromIndexes); len(differentIndexes.Slice) > 0 {
 		hasDiff = true
 
-		differences.Merge(differentIndexes)
+		differences.Merge(recreateIndexes(differentIndexes))
 	}
 
 	return hasDiff, differences
 }
 
+// recreateIndexes Replaces the modifications of any index changing Kind with a Del
+// of the existing index and an Add of the new index.  This allows orderDiffs to drop
+// the index before, and create the index after, any changes to the columns it uses.
+func recreateIndexes(diffs Differences) (result Differences) {
+
+	recreate := map[string]DiffPair{}
+
+	for _, diff := range diffs.Slice {
+		if diff.Op == Mod && diff.Property == "Kind" {
+			recreate[diff.Metadata.PropertyID] = diff.Value.(DiffPair)
+		}
+	}
+
+	if len(recreate) == 0 {
+		return diffs
+	}
+
+	for _, diff := range diffs.Slice {
+		dp, found := recreate[diff.Metadata.PropertyID]
+
+		if diff.Op != Mod || !found {
+			result.Add(diff)
+			continue
+		}
+
+		// Only the Kind diff is replaced, all other modifications are superseded
+		if diff.Property == "Kind" {
+			fromIndex := dp.From.(Index)
+			toIndex := dp.To.(Index)
+
+			result.Add(Diff{
+				Table:    diff.Table,
+				Field:    diff.Field,
+				Op:       Del,
+				Property: fromIndex.Name,
+				Value:    fromIndex,
+				Metadata: fromIndex.Metadata,
+			})
+			result.Add(Diff{
+				Table:    diff.Table,
+				Field:    diff.Field,
+				Op:       Add,
+				Property: toIndex.Name,
+				Value:    toIndex,
+				Metadata: toIndex.Metadata,
+			})
+		}
+	}
+	return result
+}
+
+// collapseModifications Keeps only the first Mod diff of each property.  Properties
+// which can't be altered in place, such as Foreign Keys and Check Constraints, are dropped and recreated from
+// the complete DiffPair of the Mod diff, so a single diff covers every changed field.
+func collapseModifications(diffs Differences) (result Differences) {
+
+	modified := map[string]bool{}
+
+	for _, diff := range diffs.Slice {
+		if diff.Op == Mod {
+			if modified[diff.Metadata.PropertyID] {
+				continue
+			}
+			modified[diff.Metadata.PropertyID] = true
+		}
+		result.Add(diff)
+	}
+	return result
+}
+
+func diffForeignKeys(toTable Table, fromTable Table) (hasDiff bool, differences Differences) {
+
+	toForeignKeys := make([]interface{}, len(toTable.ForeignKeys))
+	for i, v := range toTable.ForeignKeys {
+		toForeignKeys[i] = v
+	}
+
+	fromForeignKeys := make([]interface{}, len(fromTable.ForeignKeys))
+	for i, v := range fromTable.ForeignKeys {
+		fromForeignKeys[i] = v
+	}
+
+	// Foreign Key Properties
+	fieldNames := []string{"Name", "Columns", "ReferenceTable", "ReferenceColumns", "OnDelete", "OnUpdate"}
+
+	if differentForeignKeys := diffProperties(toTable.Name, "ForeignKeys", fieldNames, toForeignKeys, fromForeignKeys); len(differentForeignKeys.Slice) > 0 {
+		hasDiff = true
+
+		differences.Merge(collapseModifications(differentForeignKeys))
+	}
+
+	return hasDiff, differences
+}
+
+func diffCheckConstraints(toTable Table, fromTable Table) (hasDiff bool, differences Differences) {
+
+	toChecks := make([]interface{}, len(toTable.CheckConstraints))
+	for i, v := range toTable.CheckConstraints {
+		toChecks[i] = v
+	}
+
+	fromChecks := make([]interface{}, len(fromTable.CheckConstraints))
+	for i, v := range fromTable.CheckConstraints {
+		fromChecks[i] = v
+	}
+
+	// Check Constraint Properties
+	fieldNames := []string{"Name", "Expression", "NotEnforced"}
+
+	if differentChecks := diffProperties(toTable.Name, "CheckConstraints", fieldNames, toChecks, fromChecks); len(differentChecks.Slice) > 0 {
+		hasDiff = true
+
+		differences.Merge(collapseModifications(differentChecks))
+	}
+
+	return hasDiff, differences
+}
+
+// diffPartitions Compare the partitioning of the tables.  Changes to the partitioning
+// scheme require the table to be repartitioned, otherwise RANGE and LIST partitions are
+// added, dropped and reorganised individually.
+func diffPartitions(toTable Table, fromTable Table) (hasDiff bool, differences Differences) {
+	to := toTable.Partitioning
+	from := fromTable.Partitioning
+
+	if !to.HasSameScheme(from) {
+		differences.Add(Diff{
+			Table:    toTable.Name,
+			Field:    "Partitioning",
+			Op:       Mod,
+			Property: "Partitioning",
+			Value: DiffPair{
+				From: from,
+				To:   to,
+			},
+			Metadata: fromTable.Metadata,
+		})
+		return true, differences
+	}
+
+	if !to.HasValues() {
+		return false, differences
+	}
+
+	// Dropped partitions
+	for _, part := range from.Partitions {
+		if _, found := to.GetPartition(part.Name); !found {
+			differences.Add(Diff{
+				Table:    toTable.Name,
+				Field:    "Partitions",
+				Op:       Del,
+				Property: part.Name,
+				Value:    from.WithPartitions(part),
+				Metadata: fromTable.Metadata,
+			})
+		}
+	}
+
+	// New partitions are added to the end of the table, or for RANGE partitions
+	// reorganised out of the existing partition which follows them.
+	var pending []Partition
+
+	for _, part := range to.Partitions {
+		existing, found := from.GetPartition(part.Name)
+
+		if !found {
+			pending = append(pending, part)
+			continue
+		}
+
+		if (len(pending) > 0 && to.Type == PartitionRange) || existing.Values != part.Values {
+			if to.Type != PartitionRange {
+				// LIST partitions can be added in any order
+				for _, newPart := range pending {
+					differences.Add(Diff{
+						Table:    toTable.Name,
+						Field:    "Partitions",
+						Op:       Add,
+						Property: newPart.Name,
+						Value:    to.WithPartitions(newPart),
+						Metadata: fromTable.Metadata,
+					})
+				}
+				pending = nil
+			}
+
+			differences.Add(Diff{
+				Table:    toTable.Name,
+				Field:    "Partitions",
+				Op:       Mod,
+				Property: part.Name,
+				Value: DiffPair{
+					From: from.WithPartitions(existing),
+					To:   to.WithPartitions(append(pending, part)...),
+				},
+				Metadata: fromTable.Metadata,
+			})
+			pending = nil
+		}
+	}
+
+	for _, newPart := range pending {
+		differences.Add(Diff{
+			Table:    toTable.Name,
+			Field:    "Partitions",
+			Op:       Add,
+			Property: newPart.Name,
+			Value:    to.WithPartitions(newPart),
+			Metadata: fromTable.Metadata,
+		})
+	}
+
+	return len(differences.Slice) > 0, differences
+}
+
 func diffTable(toTable Table, fromTable Table) (hasDiff bool, differences Differences) {
 	hasDiff = false
 
 	// Table Fields
-	fieldNames := []string{"Name", "Engine", "CharSet", "AutoInc", "RowFormat", "Collation"}
+	fieldNames := []string{"Name", "Engine", "CharSet", "AutoInc", "RowFormat", "Collation", "Comment"}
 
 	for _, field := range fieldNames {
 		if diffFound, fieldsDiff := Compare(fromTable.Name, field, toTable, fromTable); diffFound {
@@ -423,6 +664,24 @@ func diffTable(toTable Table, fromTable Table) (hasDiff bool, differences Differ
 		differences.Merge(indexesDiff)
 	}
 
+	// Table Foreign Keys
+	if diffFound, foreignKeysDiff := diffForeignKeys(toTable, fromTable); diffFound {
+		hasDiff = diffFound
+		differences.Merge(foreignKeysDiff)
+	}
+
+	// Table Check Constraints
+	if diffFound, checksDiff := diffCheckConstraints(toTable, fromTable); diffFound {
+		hasDiff = diffFound
+		differences.Merge(checksDiff)
+	}
+
+	// Table Partitions
+	if diffFound, partitionsDiff := diffPartitions(toTable, fromTable); diffFound {
+		hasDiff = diffFound
+		differences.Merge(partitionsDiff)
+	}
+
 	return hasDiff, differences
 }
 
@@ -504,7 +763,300 @@ func DiffTables(toTables []Table, fromTables []Table, dryrun bool, forward bool)
 		}
 	}
 
+	// Ensure that Foreign Keys are ordered correctly against the tables they reference
+	tableDiffs, err = orderTableDiffs(tableDiffs)
+
 	util.LogInfo("Finished Diff")
 
 	return tableDiffs, err
 }
+
+// DiffViews Compare the toViews and fromViews Slices of View structs and
+// return a Differences Slice containing all of the differences between the views.
+// Views are replaced in their entirety, so each changed View produces a single
+// Mod diff.
+func DiffViews(toViews []View, fromViews []View, dryrun bool, forward bool) (viewDiffs Differences, err error) {
+	util.LogInfo("Starting View Diff")
+
+	if !forward {
+		intViews := fromViews
+		fromViews = toViews
+		toViews = intViews
+	}
+
+	// View Fields
+	fieldNames := []string{"Name", "Definition", "Algorithm", "Security", "CheckOption"}
+
+	for i := 0; i < len(toViews); i++ {
+
+		toView := toViews[i]
+
+		found := false
+
+		// Sync the metadata for the view to the DB so that it can be
+		// detected by the Migration when it executes
+		if !dryrun {
+			err = toView.SyncDBMetadata()
+		}
+
+		if util.ErrorCheckf(err, "Problem syncing Metadata with DB for View: [%s]", toView.Name) {
+			return viewDiffs, err
+		}
+
+		for _, fromView := range fromViews {
+
+			if toView.Metadata.PropertyID == fromView.Metadata.PropertyID {
+				found = true
+
+				for _, field := range fieldNames {
+					if diffFound, fieldDiff := Compare(toView.Name, field, toView, fromView); diffFound {
+						fieldDiff.Field = "View"
+						fieldDiff.Value = DiffPair{
+							From: fromView,
+							To:   toView,
+						}
+						fieldDiff.Metadata = toView.Metadata
+						viewDiffs.Add(fieldDiff)
+						break
+					}
+				}
+			}
+		}
+		if !found {
+			// The view is a new view
+			viewDiffs.Add(Diff{
+				Table:    toView.Name,
+				Field:    "View",
+				Op:       Add,
+				Property: toView.Name,
+				Value:    toView,
+				Metadata: toView.Metadata,
+			})
+		}
+	}
+
+	// Search through the existing views for dropped views
+	for _, fromView := range fromViews {
+		found := false
+		for _, toView := range toViews {
+			if toView.Metadata.PropertyID == fromView.Metadata.PropertyID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			viewDiffs.Add(Diff{
+				Table:    fromView.Name,
+				Field:    "View",
+				Op:       Del,
+				Property: fromView.Name,
+				Value:    fromView,
+				Metadata: fromView.Metadata,
+			})
+		}
+	}
+
+	// Ensure that Views are created after the Views they select from
+	viewDiffs, err = orderViewDiffs(viewDiffs)
+
+	util.LogInfo("Finished View Diff")
+
+	return viewDiffs, err
+}
+
+// DiffRoutines Compare the toRoutines and fromRoutines Slices of Routine structs and
+// return a Differences Slice containing all of the differences between the routines.
+// Routine Definitions are compared after normalisation, and each changed Routine
+// produces a single Mod diff as Routines are dropped and recreated.
+func DiffRoutines(toRoutines []Routine, fromRoutines []Routine, dryrun bool, forward bool) (routineDiffs Differences, err error) {
+	util.LogInfo("Starting Routine Diff")
+
+	if !forward {
+		intRoutines := fromRoutines
+		fromRoutines = toRoutines
+		toRoutines = intRoutines
+	}
+
+	sameRoutine := func(to Routine, from Routine) bool {
+		return to.Metadata.PropertyID == from.Metadata.PropertyID && to.Type == from.Type
+	}
+
+	for i := 0; i < len(toRoutines); i++ {
+
+		toRoutine := toRoutines[i]
+
+		found := false
+
+		// Sync the metadata for the routine to the DB so that it can be
+		// detected by the Migration when it executes
+		if !dryrun {
+			err = toRoutine.SyncDBMetadata()
+		}
+
+		if util.ErrorCheckf(err, "Problem syncing Metadata with DB for %s: [%s]", toRoutine.MetadataType(), toRoutine.Name) {
+			return routineDiffs, err
+		}
+
+		for _, fromRoutine := range fromRoutines {
+
+			if sameRoutine(toRoutine, fromRoutine) {
+				found = true
+
+				property := ""
+				if toRoutine.Name != fromRoutine.Name {
+					property = "Name"
+				} else if toRoutine.NormalisedDefinition() != fromRoutine.NormalisedDefinition() {
+					property = "Definition"
+				}
+
+				if len(property) > 0 {
+					routineDiffs.Add(Diff{
+						Table:    toRoutine.Name,
+						Field:    "Routine",
+						Op:       Mod,
+						Property: property,
+						Value: DiffPair{
+							From: fromRoutine,
+							To:   toRoutine,
+						},
+						Metadata: toRoutine.Metadata,
+					})
+				}
+			}
+		}
+		if !found {
+			// The routine is a new routine
+			routineDiffs.Add(Diff{
+				Table:    toRoutine.Name,
+				Field:    "Routine",
+				Op:       Add,
+				Property: toRoutine.Name,
+				Value:    toRoutine,
+				Metadata: toRoutine.Metadata,
+			})
+		}
+	}
+
+	// Search through the existing routines for dropped routines
+	for _, fromRoutine := range fromRoutines {
+		found := false
+		for _, toRoutine := range toRoutines {
+			if sameRoutine(toRoutine, fromRoutine) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			routineDiffs.Add(Diff{
+				Table:    fromRoutine.Name,
+				Field:    "Routine",
+				Op:       Del,
+				Property: fromRoutine.Name,
+				Value:    fromRoutine,
+				Metadata: fromRoutine.Metadata,
+			})
+		}
+	}
+
+	// Ensure that Functions and Procedures exist before the Triggers which call them
+	routineDiffs = orderRoutineDiffs(routineDiffs)
+
+	util.LogInfo("Finished Routine Diff")
+
+	return routineDiffs, err
+}
+
+// DiffEvents Compare the toEvents and fromEvents Slices of Event structs and
+// return a Differences Slice containing all of the differences between the events.
+// Schedules and Bodies are compared after normalisation, and each changed Event
+// produces a single Mod diff as all of its clauses are applied by ALTER EVENT.
+func DiffEvents(toEvents []Event, fromEvents []Event, dryrun bool, forward bool) (eventDiffs Differences, err error) {
+	util.LogInfo("Starting Event Diff")
+
+	if !forward {
+		intEvents := fromEvents
+		fromEvents = toEvents
+		toEvents = intEvents
+	}
+
+	// Event Fields
+	fieldNames := []string{"Name", "Schedule", "OnCompletion", "Status", "Comment", "Body"}
+
+	for i := 0; i < len(toEvents); i++ {
+
+		toEvent := toEvents[i]
+
+		found := false
+
+		// Sync the metadata for the event to the DB so that it can be
+		// detected by the Migration when it executes
+		if !dryrun {
+			err = toEvent.SyncDBMetadata()
+		}
+
+		if util.ErrorCheckf(err, "Problem syncing Metadata with DB for Event: [%s]", toEvent.Name) {
+			return eventDiffs, err
+		}
+
+		for _, fromEvent := range fromEvents {
+
+			if toEvent.Metadata.PropertyID == fromEvent.Metadata.PropertyID {
+				found = true
+
+				// Only compare the STARTS time if both Events define one
+				withStartTime := toEvent.HasStartTime() && fromEvent.HasStartTime()
+				toCompare := toEvent.Normalised(withStartTime)
+				fromCompare := fromEvent.Normalised(withStartTime)
+
+				for _, field := range fieldNames {
+					if diffFound, fieldDiff := Compare(toEvent.Name, field, toCompare, fromCompare); diffFound {
+						fieldDiff.Field = "Event"
+						fieldDiff.Value = DiffPair{
+							From: fromEvent,
+							To:   toEvent,
+						}
+						fieldDiff.Metadata = toEvent.Metadata
+						eventDiffs.Add(fieldDiff)
+						break
+					}
+				}
+			}
+		}
+		if !found {
+			// The event is a new event
+			eventDiffs.Add(Diff{
+				Table:    toEvent.Name,
+				Field:    "Event",
+				Op:       Add,
+				Property: toEvent.Name,
+				Value:    toEvent,
+				Metadata: toEvent.Metadata,
+			})
+		}
+	}
+
+	// Search through the existing events for dropped events
+	for _, fromEvent := range fromEvents {
+		found := false
+		for _, toEvent := range toEvents {
+			if toEvent.Metadata.PropertyID == fromEvent.Metadata.PropertyID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			eventDiffs.Add(Diff{
+				Table:    fromEvent.Name,
+				Field:    "Event",
+				Op:       Del,
+				Property: fromEvent.Name,
+				Value:    fromEvent,
+				Metadata: fromEvent.Metadata,
+			})
+		}
+	}
+
+	util.LogInfo("Finished Event Diff")
+
+	return eventDiffs, err
+}
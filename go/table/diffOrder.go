@@ -101,10 +101,164 @@ func getDiffName(diff Diff) string {
 	return name
 }
 
+// diffObjectName Helper function. Extract the name of the Column, Index,
+// ForeignKey or CheckConstraint that a Diff is operating on.  Mod diffs use the Property to store
+// the modified field, so the name is extracted from the Diff's value instead.
+func diffObjectName(diff Diff) string {
+	if dp, ok := diff.Value.(DiffPair); ok {
+		switch v := dp.To.(type) {
+		case Column:
+			return v.Name
+		case Index:
+			return v.Name
+		case ForeignKey:
+			return v.Name
+		case CheckConstraint:
+			return v.Name
+		case View:
+			return v.Name
+		case Routine:
+			return v.Name
+		case Event:
+			return v.Name
+		}
+	}
+	return diff.Property
+}
+
+// getDiffKey Helper function. Generate a unique key for a diff using the name
+// of the object being modified and the operation type
+func getDiffKey(diff Diff) string {
+	name := diffObjectName(diff)
+	switch {
+	case diff.Op == Add:
+		return name + "_add"
+	case diff.Op == Del:
+		return name + "_del"
+	case diff.Op == Mod:
+		return name + "_mod"
+	}
+	return name
+}
+
+// isIndexDiff Helper function. Is the diff operating on an Index
+func isIndexDiff(diff Diff) bool {
+	return diff.Field == "PrimaryIndex" || diff.Field == "SecondaryIndexes"
+}
+
+// buildIndexNode Build a DiffNode for an Index diff, recording the columns used by the index
+func buildIndexNode(diff Diff) *DiffNode {
+	diffNode := NewDiffNode(diff)
+
+	// Indexes are only Mod if the AutoInc property is being changed.
+	if diff.Op == Mod {
+
+		// Build the list of Columns used by the index
+		dp, ok := diff.Value.(DiffPair)
+		if !ok {
+			util.LogErrorf("Problem extracting DiffPair from Index Diff")
+		}
+
+		fromInd := dp.From.(Index)
+		toInd := dp.To.(Index)
+		for _, col := range fromInd.Columns {
+			diffNode.AddColumn(col.Name)
+			util.LogErrorf("From Index: %s using column: %s", fromInd.Name, col.Name)
+		}
+
+		for _, col := range toInd.Columns {
+			diffNode.AddColumn(col.Name)
+			util.LogErrorf("To Index: %s using column: %s", toInd.Name, col.Name)
+		}
+
+	} else {
+
+		index, ok := diff.Value.(Index)
+
+		if !ok {
+			util.LogErrorf("Problem extracting Index from Index Diff")
+		}
+
+		for _, col := range index.Columns {
+			util.LogErrorf("Adding Index: %s using column: %s", index.Name, col.Name)
+			diffNode.AddColumn(col.Name)
+		}
+	}
+	return &diffNode
+}
+
+// columnStates Helper function. Extract the Column state before and after a Column diff
+func columnStates(diff Diff) (from Column, to Column) {
+	switch v := diff.Value.(type) {
+	case Column:
+		if diff.Op == Del {
+			from = v
+		} else {
+			to = v
+		}
+	case DiffPair:
+		from, _ = v.From.(Column)
+		to, _ = v.To.(Column)
+	}
+	return from, to
+}
+
+// buildForeignKeyNode Build a DiffNode for a ForeignKey diff, recording the local
+// columns used by the constraint.  Self referencing constraints also record the
+// referenced columns as they exist in the same table.
+func buildForeignKeyNode(diff Diff) *DiffNode {
+	diffNode := NewDiffNode(diff)
+
+	fks := []ForeignKey{}
+
+	if dp, ok := diff.Value.(DiffPair); ok {
+		fks = append(fks, dp.From.(ForeignKey), dp.To.(ForeignKey))
+	} else if fk, ok := diff.Value.(ForeignKey); ok {
+		fks = append(fks, fk)
+	} else {
+		util.LogErrorf("Problem extracting ForeignKey from ForeignKey Diff")
+	}
+
+	for _, fk := range fks {
+		for _, col := range fk.Columns {
+			diffNode.AddColumn(col)
+		}
+		if fk.ReferenceTable == diff.Table {
+			for _, col := range fk.ReferenceColumns {
+				diffNode.AddColumn(col)
+			}
+		}
+	}
+	return &diffNode
+}
+
+// buildCheckConstraintNode Build a DiffNode for a CheckConstraint diff, recording the
+// columns used by the check expression.
+func buildCheckConstraintNode(diff Diff) *DiffNode {
+	diffNode := NewDiffNode(diff)
+
+	checks := []CheckConstraint{}
+
+	if dp, ok := diff.Value.(DiffPair); ok {
+		checks = append(checks, dp.From.(CheckConstraint), dp.To.(CheckConstraint))
+	} else if chk, ok := diff.Value.(CheckConstraint); ok {
+		checks = append(checks, chk)
+	} else {
+		util.LogErrorf("Problem extracting CheckConstraint from CheckConstraint Diff")
+	}
+
+	for _, chk := range checks {
+		for _, col := range chk.ReferencedColumns() {
+			diffNode.AddColumn(col)
+		}
+	}
+	return &diffNode
+}
+
 // orderDiffs Post Process sort the diff operations by building and traversing
 // a Directed Acyclic Graph. This is intended to prevent issues such as columns
-// being dropped before an associated index is removed / updated, or indexes
-// being created before columns exist
+// being dropped before an associated index or foreign key is removed / updated,
+// or indexes and foreign keys being created before columns exist
 func orderDiffs(diffs Differences, forward bool) (orderedDiffs Differences, err error) {
 
 	// If there's only a single item, early out
@@ -117,192 +271,436 @@ func orderDiffs(diffs Differences, forward bool) (orderedDiffs Differences, err
 	// i.e. if a column is removed, there will be a matching diff which removes
 	//      the column from the index
 
-	// Check for indexes
+	// Table level diffs aren't dependent on columns or indexes and are kept
+	// in their original order ahead of any sorted diffs
+	var tableDiffs []Diff
 
-	hasIndexes := false
+	var indexNodes []*DiffNode
+	var fkNodes []*DiffNode
+	var checkNodes []*DiffNode
+	var colNodes []*DiffNode
 
 	indexDiffs := make(map[string]*DiffNode)
+	fkDiffs := make(map[string]*DiffNode)
+	checkDiffs := make(map[string]*DiffNode)
 	colDiffs := make(map[string]*DiffNode)
 
 	for _, diff := range diffs.Slice {
 
-		if diff.Field == "PrimaryIndex" || diff.Field == "SecondaryIndexes" {
-			hasIndexes = true
+		if isIndexDiff(diff) {
+			diffNode := buildIndexNode(diff)
+			indexDiffs[getDiffKey(diff)] = diffNode
+			indexNodes = append(indexNodes, diffNode)
 
-			diffNode := NewDiffNode(diff)
+		} else if diff.Field == "ForeignKeys" {
+			diffNode := buildForeignKeyNode(diff)
+			fkDiffs[getDiffKey(diff)] = diffNode
+			fkNodes = append(fkNodes, diffNode)
 
-			// Indexes are only Mod if the AutoInc property is being changed.
-			if diff.Op == Mod {
+		} else if diff.Field == "CheckConstraints" {
+			diffNode := buildCheckConstraintNode(diff)
+			checkDiffs[getDiffKey(diff)] = diffNode
+			checkNodes = append(checkNodes, diffNode)
 
-				// Build the list of Columns used by the index
-				dp, ok := diff.Value.(DiffPair)
-				if !ok {
-					util.LogErrorf("Problem extracting DiffPair from Index Diff")
-				}
+		} else if diff.Field == "Columns" {
+			diffNode := NewDiffNode(diff)
+			diffNode.AddColumn(diffObjectName(diff))
+			colDiffs[getDiffKey(diff)] = &diffNode
+			colNodes = append(colNodes, &diffNode)
 
-				fromInd := dp.From.(Index)
-				toInd := dp.To.(Index)
-				for _, col := range fromInd.Columns {
-					diffNode.AddColumn(col.Name)
-					util.LogErrorf("From Index: %s using column: %s", fromInd.Name, col.Name)
-				}
+		} else {
+			tableDiffs = append(tableDiffs, diff)
+		}
+	}
+
+	// Rules of dependency
+	//
+	// ADD Col -> None
+	// DEL/MOD Col -> DEL/MOD Index, DEL/MOD ForeignKey, DEL/MOD CheckConstraint
+	//
+	// ADD Ind -> ADD/DEL/MOD Col
+	// MOD Ind -> ADD/DEL Col
+	// DEL Ind -> DEL/MOD ForeignKey
+	//
+	// ADD ForeignKey -> ADD/DEL/MOD Col
+	// MOD ForeignKey -> ADD/DEL Col
+	// DEL ForeignKey -> None
+	//
+	// ADD CheckConstraint -> ADD/DEL/MOD Col
+	// MOD CheckConstraint -> ADD/DEL Col
+	// DEL CheckConstraint -> None
+	//
+	// ADD/MOD Generated Col -> ADD Col used by the expression
+	// DEL Col -> DEL/MOD Generated Col using the column
+	//
+	// Recreated Indexes, ForeignKeys and CheckConstraints are dropped before they are re-added
+
+	hasDependencies := false
+
+	addDependency := func(node *DiffNode, dep *DiffNode) {
+		node.AddDependency(dep)
+		hasDependencies = true
+	}
 
-				for _, col := range toInd.Columns {
-					diffNode.AddColumn(col.Name)
-					util.LogErrorf("To Index: %s using column: %s", toInd.Name, col.Name)
+	// Post Process Add/Del constraint DiffNodes.  Add is dependent on Del but can
+	// only be processed after all DiffNodes have been created.
+	for _, nodes := range []map[string]*DiffNode{indexDiffs, fkDiffs, checkDiffs} {
+		for _, node := range nodes {
+			if node.Diff.Op == Add {
+				// Get a matching Del DiffNode
+				if delNode, ok := nodes[diffObjectName(node.Diff)+"_del"]; ok {
+					addDependency(node, delNode)
 				}
+			}
+		}
+	}
 
-			} else {
+	// Constraints which are being added or modified depend on the columns they use
+	for _, node := range append(append(append([]*DiffNode{}, indexNodes...), fkNodes...), checkNodes...) {
+		if node.Diff.Op == Add || node.Diff.Op == Mod {
+			// search for column operations on any of the columns in the constraint
+			for _, col := range node.Columns {
 
-				index, ok := diff.Value.(Index)
+				// if found an Add
+				if cd, found := colDiffs[col+"_add"]; found {
+					// there's a dependency on the column
+					addDependency(node, cd)
+				}
 
-				if !ok {
-					util.LogErrorf("Problem extracting Index from Index Diff")
+				// if found a Del
+				if cd, found := colDiffs[col+"_del"]; found {
+					// there's a dependency on the column
+					addDependency(node, cd)
 				}
 
-				for _, col := range index.Columns {
-					util.LogErrorf("Adding Index: %s using column: %s", index.Name, col.Name)
-					diffNode.AddColumn(col.Name)
+				// New constraints are created after the column has been modified
+				if node.Diff.Op == Add {
+					for _, cd := range colNodes {
+						if cd.Diff.Op == Mod && diffObjectName(cd.Diff) == col {
+							addDependency(node, cd)
+						}
+					}
 				}
 			}
-			indexDiffs[getDiffName(diff)] = &diffNode
-
-		} else if diff.Field == "Columns" {
-			diffNode := NewDiffNode(diff)
-			diffNode.AddColumn(diff.Property)
-			colDiffs[getDiffName(diff)] = &diffNode
 		}
 	}
 
-	// if indexes and there are also columns diffs occurring, build a list of columns
-	if hasIndexes && len(colDiffs) > 0 {
+	// Columns which are being removed or modified depend on the constraints using them
+	for _, colNode := range colNodes {
+		if colNode.Diff.Op == Del || colNode.Diff.Op == Mod {
+			colName := diffObjectName(colNode.Diff)
+
+			// Check each index, foreign key and check constraint
+			for _, node := range append(append(append([]*DiffNode{}, indexNodes...), fkNodes...), checkNodes...) {
+				// if an existing constraint is using this column
+				if node.Diff.Op != Add && node.UsesColumn(colName) {
+					// Column is dependent on the constraint
+					addDependency(colNode, node)
+				}
+			}
+		}
+	}
 
-		indexesIndependent := true
+	// Generated columns are added after, and removed before, the columns used by their expression
+	for _, colNode := range colNodes {
+		fromCol, toCol := columnStates(colNode.Diff)
 
-		// Post Process Add/Del Index DiffNodes.  Add is dependent on Del but can only be processed after all DiffNodes have been created.
+		if colNode.Diff.Op == Add || colNode.Diff.Op == Mod {
+			for _, ref := range toCol.ReferencedColumns() {
+				if cd, found := colDiffs[ref+"_add"]; found && cd != colNode {
+					addDependency(colNode, cd)
+				}
+			}
+		}
 
-		// Check for any Add Index Diffs
-		for i, ind := range indexDiffs {
-			if ind.Diff.Op == Add {
+		if colNode.Diff.Op == Del || colNode.Diff.Op == Mod {
+			for _, ref := range fromCol.ReferencedColumns() {
+				if cd, found := colDiffs[ref+"_del"]; found && cd != colNode {
+					addDependency(cd, colNode)
+				}
+			}
+		}
+	}
 
-				delName := ind.Diff.Property + "_del"
-				// Get a matching Del DiffNode for the index
-				idf, ok := indexDiffs[delName]
-				// If found
-				if ok {
-					indexDiffs[i].AddDependency(idf)
-					indexesIndependent = false
+	// Indexes cannot be removed while a foreign key still requires them
+	for _, idxNode := range indexNodes {
+		if idxNode.Diff.Op == Del || idxNode.Diff.Op == Mod {
+			for _, fkNode := range fkNodes {
+				if fkNode.Diff.Op == Del || fkNode.Diff.Op == Mod {
+					for _, col := range fkNode.Columns {
+						if idxNode.UsesColumn(col) {
+							addDependency(idxNode, fkNode)
+							break
+						}
+					}
 				}
 			}
 		}
+	}
 
-		// check other operations for diffs on columns used indexes
-		for _, diffNode := range colDiffs {
-			colName := diffNode.Diff.Property
+	// if the operations are not independent
+	if hasDependencies {
 
-			// Check if the column is used by any index operations
-			for _, ind := range indexDiffs {
-				if ind.UsesColumn(colName) {
+		nodeRoot := DiffNode{
+			Diff: Diff{
+				Property: "ROOT",
+			},
+		}
 
-					// If it does, then the indexes are not independent
-					indexesIndependent = false
-					// halt check immediately
-					break
+		// Now check each group of nodes and add any nodes that aren't blocking to the root.
+		for _, nodes := range [][]*DiffNode{indexNodes, fkNodes, checkNodes, colNodes} {
+			for _, node := range nodes {
+				if !node.IsBlocking() {
+					nodeRoot.AddDependency(node)
 				}
 			}
 		}
 
-		// if the indexes are not independent
-		if !indexesIndependent {
+		// Traverse the dependency graph
 
-			// Rules of dependency
-			//
-			// ADD Col -> None
-			// DEL/MOD Col -> DEL/MOD Index
-			//
-			// ADD/MOD Ind -> ADD/MOD Col
-			// DEL Ind -> None
+		sortedDiffs := Differences{
+			Slice: tableDiffs,
+		}
 
-			// otherwise
-			nodeRoot := DiffNode{
-				Diff: Diff{
-					Property: "ROOT",
-				},
+		for _, node := range nodeRoot.DependsOn {
+			err = visit(node, &sortedDiffs)
+			if err != nil {
+				return diffs, err
 			}
+		}
 
-			for _, diff := range diffs.Slice {
-
-				if (diff.Op == Add || diff.Op == Mod) && (diff.Field == "PrimaryIndex" || diff.Field == "SecondaryIndexes") {
-					// Get the current index DiffNode
-					idf, ok := indexDiffs[getDiffName(diff)]
-
-					if ok {
-						// search for column operations on any of the columns in the index
-						for _, icol := range idf.Columns {
-
-							// if found an Add
-							cd, found := colDiffs[icol+"_add"]
-							if found {
-								// there's a dependency on the column
-								idf.AddDependency(cd)
-							}
-
-							// if found a Del
-							cd, found = colDiffs[icol+"_del"]
-							if found {
-								// there's a dependency on the column
-								idf.AddDependency(cd)
-							}
-						}
-					}
-				}
+		orderedDiffs = sortedDiffs
 
-				if (diff.Op == Del || diff.Op == Mod) && (diff.Field == "Columns") {
+	} else {
+		util.LogWarnf("Operations are independent.  Sorting is not required.")
+		orderedDiffs = diffs
+	}
 
-					// search for column operations
-					// get the current column DiffNode
-					cd := colDiffs[getDiffName(diff)]
+	return orderedDiffs, err
+}
 
-					// Check each index
-					for _, idxDiff := range indexDiffs {
+// foreignKeysAdded Helper function. Returns the ForeignKeys that will exist once the diff is applied
+func foreignKeysAdded(diff Diff) (fks []ForeignKey) {
+	switch v := diff.Value.(type) {
+	case ForeignKey:
+		if diff.Op == Add {
+			fks = append(fks, v)
+		}
+	case Table:
+		if diff.Op == Add {
+			fks = append(fks, v.ForeignKeys...)
+		}
+	case DiffPair:
+		if fk, ok := v.To.(ForeignKey); ok {
+			fks = append(fks, fk)
+		}
+	}
+	return fks
+}
 
-						// if the index is using this column
-						if idxDiff.UsesColumn(diff.Property) {
-							// Column is dependent on the index
-							cd.AddDependency(idxDiff)
-						}
-					}
-				}
+// foreignKeysRemoved Helper function. Returns the ForeignKeys that will no longer exist once the diff is applied
+func foreignKeysRemoved(diff Diff) (fks []ForeignKey) {
+	switch v := diff.Value.(type) {
+	case ForeignKey:
+		if diff.Op == Del {
+			fks = append(fks, v)
+		}
+	case Table:
+		if diff.Op == Del {
+			fks = append(fks, v.ForeignKeys...)
+		}
+	case DiffPair:
+		if fk, ok := v.From.(ForeignKey); ok {
+			fks = append(fks, fk)
+		}
+	}
+	return fks
+}
+
+// createsReference Helper function. Does the diff create the table or column referenced by the ForeignKey
+func createsReference(diff Diff, fk ForeignKey) bool {
+	if diff.Table != fk.ReferenceTable {
+		return false
+	}
+
+	if diff.Field == "*" {
+		return diff.Op == Add
+	}
+
+	if diff.Field == "Columns" && (diff.Op == Add || diff.Op == Mod) {
+		return fk.ReferencesColumn(diff.Table, diffObjectName(diff))
+	}
+	return false
+}
+
+// removesReference Helper function. Does the diff remove the table or column referenced by the ForeignKey
+func removesReference(diff Diff, fk ForeignKey) bool {
+	if diff.Table != fk.ReferenceTable {
+		return false
+	}
+
+	if diff.Field == "*" {
+		return diff.Op == Del
+	}
+
+	if diff.Field == "Columns" && (diff.Op == Del || diff.Op == Mod) {
+		name := diff.Property
+		if dp, ok := diff.Value.(DiffPair); ok {
+			if col, ok := dp.From.(Column); ok {
+				name = col.Name
 			}
+		}
+		return fk.ReferencesColumn(diff.Table, name)
+	}
+	return false
+}
+
+// orderTableDiffs Post Process sort the diff operations of all tables so that
+// Foreign Keys which reference other tables are created after the referenced
+// table or column, and are removed before the referenced table or column.
+// Diffs without any cross table dependencies retain their original order.
+func orderTableDiffs(diffs Differences) (orderedDiffs Differences, err error) {
+
+	nodes := make([]*DiffNode, len(diffs.Slice))
+	for i, diff := range diffs.Slice {
+		node := NewDiffNode(diff)
+		nodes[i] = &node
+	}
+
+	hasDependencies := false
+
+	for _, node := range nodes {
 
-			// Now check each diffs array and add any nodes that aren't blocking or have dependencies to the root.
-			for i, idxDiff := range indexDiffs {
-				if !idxDiff.IsBlocking() {
-					nodeRoot.AddDependency(indexDiffs[i])
+		// Adding a reference depends on the referenced table and columns existing
+		for _, fk := range foreignKeysAdded(node.Diff) {
+			for _, dep := range nodes {
+				if dep != node && dep.Diff.Table != node.Diff.Table && createsReference(dep.Diff, fk) {
+					node.AddDependency(dep)
+					hasDependencies = true
 				}
 			}
+		}
 
-			for i, colDiff := range colDiffs {
-				if !colDiff.IsBlocking() {
-					nodeRoot.AddDependency(colDiffs[i])
+		// Removing a referenced table or column depends on the reference being removed first
+		for _, dep := range nodes {
+			if dep == node || dep.Diff.Table == node.Diff.Table {
+				continue
+			}
+			for _, fk := range foreignKeysRemoved(dep.Diff) {
+				if removesReference(node.Diff, fk) {
+					node.AddDependency(dep)
+					hasDependencies = true
+					break
 				}
 			}
+		}
+	}
+
+	if !hasDependencies {
+		return diffs, nil
+	}
+
+	// Traverse the dependency graph in the original diff order
+	for _, node := range nodes {
+		err = visit(node, &orderedDiffs)
+		if err != nil {
+			return diffs, err
+		}
+	}
+
+	return orderedDiffs, err
+}
+
+// viewState Helper function. Returns the View that will exist once the diff is applied
+func viewState(diff Diff) (view View, ok bool) {
+	switch v := diff.Value.(type) {
+	case View:
+		return v, diff.Op != Del
+	case DiffPair:
+		view, ok = v.To.(View)
+	}
+	return view, ok
+}
+
+// orderViewDiffs Post Process sort the View diff operations so that Views which
+// select from other Views are created or replaced after the Views they use.
+// Diffs without any dependencies retain their original order.
+func orderViewDiffs(diffs Differences) (orderedDiffs Differences, err error) {
+
+	nodes := make([]*DiffNode, len(diffs.Slice))
+	for i, diff := range diffs.Slice {
+		node := NewDiffNode(diff)
+		nodes[i] = &node
+	}
 
-			// Traverse the dependency graph
+	hasDependencies := false
 
-			var sortedDiffs Differences
+	for _, node := range nodes {
+		view, ok := viewState(node.Diff)
+		if !ok {
+			continue
+		}
 
-			for _, node := range nodeRoot.DependsOn {
-				visit(node, &sortedDiffs)
+		for _, dep := range nodes {
+			if dep == node {
+				continue
+			}
+			if used, ok := viewState(dep.Diff); ok && view.UsesTable(used.Name) {
+				node.AddDependency(dep)
+				hasDependencies = true
 			}
+		}
+	}
 
-			orderedDiffs = sortedDiffs
+	if !hasDependencies {
+		return diffs, nil
+	}
 
-		} else {
-			util.LogWarnf("Operations are independent.  Sorting is not required.")
-			orderedDiffs = diffs
+	// Traverse the dependency graph in the original diff order
+	for _, node := range nodes {
+		err = visit(node, &orderedDiffs)
+		if err != nil {
+			return diffs, err
 		}
 	}
 
 	return orderedDiffs, err
 }
+
+// orderRoutineDiffs Post Process sort the Routine diff operations so that all
+// Routines are dropped before any are created. Triggers are dropped first and
+// created last so that the Functions and Procedures they call exist.
+func orderRoutineDiffs(diffs Differences) (orderedDiffs Differences) {
+
+	routineType := func(diff Diff) string {
+		switch v := diff.Value.(type) {
+		case Routine:
+			return v.Type
+		case DiffPair:
+			if r, ok := v.To.(Routine); ok {
+				return r.Type
+			}
+		}
+		return ""
+	}
+
+	// Drop Triggers, then Procedures, then Functions
+	for i := len(RoutineTypes) - 1; i >= 0; i-- {
+		for _, diff := range diffs.Slice {
+			if diff.Op == Del && routineType(diff) == RoutineTypes[i] {
+				orderedDiffs.Add(diff)
+			}
+		}
+	}
+
+	// Create Functions, then Procedures, then Triggers
+	for _, rt := range RoutineTypes {
+		for _, diff := range diffs.Slice {
+			if diff.Op != Del && routineType(diff) == rt {
+				orderedDiffs.Add(diff)
+			}
+		}
+	}
+
+	return orderedDiffs
+}
@@ -251,6 +251,162 @@ var diffTests = []DiffTest{
 		ExpectFail:  false,
 		Description: "Column Field Diff: Rename",
 	},
+	{
+		From: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:       "col1",
+					Name:     "Add",
+					Type:     "varchar",
+					Size:     []int{64},
+					Nullable: false,
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:       "col1",
+					Name:     "Address",
+					Type:     "varchar",
+					Size:     []int{128},
+					Nullable: true,
+					Comment:  "Postal Address",
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Op:       Mod,
+				Field:    "Columns",
+				Property: "Name",
+				Value: DiffPair{
+					From: Column{
+						ID:   "col1",
+						Name: "Add",
+						Type: "varchar",
+						Size: []int{64},
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+					To: Column{
+						ID:       "col1",
+						Name:     "Address",
+						Type:     "varchar",
+						Size:     []int{128},
+						Nullable: true,
+						Comment:  "Postal Address",
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "col1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Column Field Diff: Rename and modify definition",
+	},
+	{
+		From: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:   "col1",
+					Name: "OldAddress",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				Column{
+					ID:   "col2",
+					Name: "Address",
+					Type: "text",
+					Metadata: metadata.Metadata{
+						PropertyID: "col2",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Op:       Add,
+				Field:    "Columns",
+				Property: "Address",
+				Value: Column{
+					ID:   "col2",
+					Name: "Address",
+					Type: "text",
+					Metadata: metadata.Metadata{
+						PropertyID: "col2",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "col2",
+				},
+			},
+			Diff{
+				Table:    "TestTable",
+				Op:       Mod,
+				Field:    "Columns",
+				Property: "Name",
+				Value: DiffPair{
+					From: Column{
+						ID:   "col1",
+						Name: "Address",
+						Type: "varchar",
+						Size: []int{64},
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+					To: Column{
+						ID:   "col1",
+						Name: "OldAddress",
+						Type: "varchar",
+						Size: []int{64},
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "col1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Column Field Diff: Rename and add a column with the previous name",
+	},
 	{
 		From: Table{
 			Name: "TestTable",
@@ -1474,96 +1630,663 @@ var diffTests = []DiffTest{
 		ExpectFail:  false,
 		Description: "Secondary Index Field Diff: Change IsUnqiue",
 	},
-}
-
-func TestDifferences(t *testing.T) {
-	util.VerboseOverrideSet(true)
-	for _, test := range diffTests {
-		util.LogAttentionf("Testing: %s", test.Description)
-		hasDiff, difference := diffTable(test.To, test.From)
-
-		if len(difference.Slice) != len(test.Expected) {
-			t.Errorf("%s Failed. Unexpected Differences", test.Description)
-
-			util.DebugDumpDiff(test.Expected, difference.Slice)
-		}
-
-		if hasDiff {
 
-			diffs := difference.Slice
-
-			// If difference is INCORRECT
-			if !test.ExpectFail && !reflect.DeepEqual(diffs, test.Expected) {
-				t.Errorf("%s Failed. Difference is not correct", test.Description)
-
-				util.LogAttentionf("%s Failed. Return object differs from expected object.", test.Description)
-				util.DebugDumpDiff(test.Expected, diffs)
-
-				// if the difference is CORRECT and we expected it to be INCORRECT!
-			} else if !test.ExpectFail && reflect.DeepEqual(diffs, test.Expected) {
-				// Success
-
-				// if the difference is INCORECT and we expected it to be INCORRECT
-			} else if test.ExpectFail && !reflect.DeepEqual(diffs, test.Expected) {
-				// Successfully failed
-			}
-		}
-
-	}
-}
-
-func TestTables(t *testing.T) {
-
-	var fromTables = []Table{
-		Table{
+	{
+		From: Table{
 			Name: "TestTable",
-			Columns: []Column{
-				Column{
-					ID:   "col1",
-					Name: "Address",
-					Type: "varchar",
-					Size: []int{64},
+			SecondaryIndexes: []Index{
+				Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
 					Metadata: metadata.Metadata{
-						PropertyID: "col1",
+						PropertyID: "sc1",
 					},
 				},
 			},
 		},
-	}
-
-	var toTables = []Table{
-		Table{
+		To: Table{
 			Name: "TestTable",
-			Columns: []Column{
-				Column{
-					ID:   "col1",
-					Name: "Address",
-					Type: "varchar",
-					Size: []int{64},
+			SecondaryIndexes: []Index{
+				Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Kind: IndexFullText,
 					Metadata: metadata.Metadata{
-						PropertyID: "col1",
+						PropertyID: "sc1",
 					},
 				},
-				Column{
-					ID:   "col1",
-					Name: "Age",
-					Type: "int",
-					Size: []int{11},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "SecondaryIndexes",
+				Op:       Del,
+				Property: "idx_body",
+				Value: Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
 					Metadata: metadata.Metadata{
-						PropertyID: "col2",
+						PropertyID: "sc1",
 					},
 				},
+				Metadata: metadata.Metadata{
+					PropertyID: "sc1",
+				},
 			},
-		},
-	}
-
-	var expectedDiffs = []Diff{
-		Diff{
-			Table:    "TestTable",
-			Field:    "Columns",
-			Op:       Add,
-			Property: "Age",
-			Value: Column{
+			Diff{
+				Table:    "TestTable",
+				Field:    "SecondaryIndexes",
+				Op:       Add,
+				Property: "idx_body",
+				Value: Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Kind: IndexFullText,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "sc1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Secondary Index Field Diff: Change Kind recreates the Index",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+		},
+		To: Table{
+			Name: "TestTable",
+			ForeignKeys: []ForeignKey{
+				ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "ForeignKeys",
+				Op:       Add,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Foreign Key Diff: Add Foreign Key",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:      "col1",
+					Name:    "Address",
+					Type:    "varchar",
+					Size:    []int{64},
+					Comment: "Street address",
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "Columns",
+				Op:       Mod,
+				Property: "Comment",
+				Value: DiffPair{
+					From: Column{
+						ID:   "col1",
+						Name: "Address",
+						Type: "varchar",
+						Size: []int{64},
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+					To: Column{
+						ID:      "col1",
+						Name:    "Address",
+						Type:    "varchar",
+						Size:    []int{64},
+						Comment: "Street address",
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "col1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Column Field Diff: Change Comment",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "medium"},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:     "col1",
+					Name:   "Size",
+					Type:   "enum",
+					Values: []string{"small", "medium", "large"},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "Columns",
+				Op:       Mod,
+				Property: "Values",
+				Value: DiffPair{
+					From: Column{
+						ID:     "col1",
+						Name:   "Size",
+						Type:   "enum",
+						Values: []string{"small", "medium"},
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+					To: Column{
+						ID:     "col1",
+						Name:   "Size",
+						Type:   "enum",
+						Values: []string{"small", "medium", "large"},
+						Metadata: metadata.Metadata{
+							PropertyID: "col1",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "col1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Column Field Diff: Append ENUM member",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+			ForeignKeys: []ForeignKey{
+				ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			ForeignKeys: []ForeignKey{
+				ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					OnDelete:         "CASCADE",
+					Metadata: metadata.Metadata{
+						PropertyID: "fk1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "ForeignKeys",
+				Op:       Mod,
+				Property: "OnDelete",
+				Value: DiffPair{
+					From: ForeignKey{
+						ID:               "fk1",
+						Name:             "fk_user",
+						Columns:          []string{"user_id"},
+						ReferenceTable:   "user",
+						ReferenceColumns: []string{"id"},
+						Metadata: metadata.Metadata{
+							PropertyID: "fk1",
+						},
+					},
+					To: ForeignKey{
+						ID:               "fk1",
+						Name:             "fk_user",
+						Columns:          []string{"user_id"},
+						ReferenceTable:   "user",
+						ReferenceColumns: []string{"id"},
+						OnDelete:         "CASCADE",
+						Metadata: metadata.Metadata{
+							PropertyID: "fk1",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Foreign Key Diff: Change OnDelete",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+		},
+		To: Table{
+			Name: "TestTable",
+			CheckConstraints: []CheckConstraint{
+				CheckConstraint{
+					ID:         "chk1",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "CheckConstraints",
+				Op:       Add,
+				Property: "chk_price",
+				Value: CheckConstraint{
+					ID:         "chk1",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "chk1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Check Constraint Diff: Add Check Constraint",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+			CheckConstraints: []CheckConstraint{
+				CheckConstraint{
+					ID:         "chk1",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			CheckConstraints: []CheckConstraint{
+				CheckConstraint{
+					ID:         "chk1",
+					Name:       "chk_price",
+					Expression: "`price` >= 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk1",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "CheckConstraints",
+				Op:       Mod,
+				Property: "Expression",
+				Value: DiffPair{
+					From: CheckConstraint{
+						ID:         "chk1",
+						Name:       "chk_price",
+						Expression: "`price` > 0",
+						Metadata: metadata.Metadata{
+							PropertyID: "chk1",
+						},
+					},
+					To: CheckConstraint{
+						ID:         "chk1",
+						Name:       "chk_price",
+						Expression: "`price` >= 0",
+						Metadata: metadata.Metadata{
+							PropertyID: "chk1",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "chk1",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Check Constraint Diff: Change Expression",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+			Partitioning: Partitioning{
+				Type:       PartitionRange,
+				Expression: "to_days(`created`)",
+				Partitions: []Partition{
+					{
+						Name:   "p2016",
+						Values: "(736330)",
+					},
+					{
+						Name:   "p2017",
+						Values: "(736695)",
+					},
+					{
+						Name:   "pmax",
+						Values: "MAXVALUE",
+					},
+				},
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			Partitioning: Partitioning{
+				Type:       PartitionRange,
+				Expression: "to_days(`created`)",
+				Partitions: []Partition{
+					{
+						Name:   "p2017",
+						Values: "(736695)",
+					},
+					{
+						Name:   "p2018",
+						Values: "(737060)",
+					},
+					{
+						Name:   "pmax",
+						Values: "MAXVALUE",
+					},
+				},
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "Partitions",
+				Op:       Del,
+				Property: "p2016",
+				Value: Partitioning{
+					Type:       PartitionRange,
+					Expression: "to_days(`created`)",
+					Partitions: []Partition{
+						{
+							Name:   "p2016",
+							Values: "(736330)",
+						},
+					},
+				},
+			},
+			Diff{
+				Table:    "TestTable",
+				Field:    "Partitions",
+				Op:       Mod,
+				Property: "pmax",
+				Value: DiffPair{
+					From: Partitioning{
+						Type:       PartitionRange,
+						Expression: "to_days(`created`)",
+						Partitions: []Partition{
+							{
+								Name:   "pmax",
+								Values: "MAXVALUE",
+							},
+						},
+					},
+					To: Partitioning{
+						Type:       PartitionRange,
+						Expression: "to_days(`created`)",
+						Partitions: []Partition{
+							{
+								Name:   "p2018",
+								Values: "(737060)",
+							},
+							{
+								Name:   "pmax",
+								Values: "MAXVALUE",
+							},
+						},
+					},
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Partition Diff: Drop and Reorganize RANGE Partitions",
+	},
+
+	{
+		From: Table{
+			Name: "TestTable",
+			Partitioning: Partitioning{
+				Type:       PartitionHash,
+				Expression: "`id`",
+				Count:      4,
+			},
+		},
+		To: Table{
+			Name: "TestTable",
+			Partitioning: Partitioning{
+				Type:       PartitionHash,
+				Expression: "`id`",
+				Count:      8,
+			},
+		},
+		Expected: []Diff{
+			Diff{
+				Table:    "TestTable",
+				Field:    "Partitioning",
+				Op:       Mod,
+				Property: "Partitioning",
+				Value: DiffPair{
+					From: Partitioning{
+						Type:       PartitionHash,
+						Expression: "`id`",
+						Count:      4,
+					},
+					To: Partitioning{
+						Type:       PartitionHash,
+						Expression: "`id`",
+						Count:      8,
+					},
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Partition Diff: Change HASH Partition Count",
+	},
+}
+
+func TestDifferences(t *testing.T) {
+	util.VerboseOverrideSet(true)
+	for _, test := range diffTests {
+		util.LogAttentionf("Testing: %s", test.Description)
+		hasDiff, difference := diffTable(test.To, test.From)
+
+		if len(difference.Slice) != len(test.Expected) {
+			t.Errorf("%s Failed. Unexpected Differences", test.Description)
+
+			util.DebugDumpDiff(test.Expected, difference.Slice)
+		}
+
+		if hasDiff {
+
+			diffs := difference.Slice
+
+			// If difference is INCORRECT
+			if !test.ExpectFail && !reflect.DeepEqual(diffs, test.Expected) {
+				t.Errorf("%s Failed. Difference is not correct", test.Description)
+
+				util.LogAttentionf("%s Failed. Return object differs from expected object.", test.Description)
+				util.DebugDumpDiff(test.Expected, diffs)
+
+				// if the difference is CORRECT and we expected it to be INCORRECT!
+			} else if !test.ExpectFail && reflect.DeepEqual(diffs, test.Expected) {
+				// Success
+
+				// if the difference is INCORECT and we expected it to be INCORRECT
+			} else if test.ExpectFail && !reflect.DeepEqual(diffs, test.Expected) {
+				// Successfully failed
+			}
+		}
+
+	}
+}
+
+func TestTables(t *testing.T) {
+
+	var fromTables = []Table{
+		Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+		},
+	}
+
+	var toTables = []Table{
+		Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:   "col1",
+					Name: "Address",
+					Type: "varchar",
+					Size: []int{64},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+				Column{
+					ID:   "col1",
+					Name: "Age",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "col2",
+					},
+				},
+			},
+		},
+	}
+
+	var expectedDiffs = []Diff{
+		Diff{
+			Table:    "TestTable",
+			Field:    "Columns",
+			Op:       Add,
+			Property: "Age",
+			Value: Column{
 				ID:   "col1",
 				Name: "Age",
 				Type: "int",
@@ -1597,3 +2320,360 @@ func TestTables(t *testing.T) {
 		}
 	}
 }
+
+func TestTablesUnsigned(t *testing.T) {
+
+	signedTables := []Table{
+		Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:   "col1",
+					Name: "Age",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "tbl1",
+			},
+		},
+	}
+
+	unsignedTables := []Table{
+		Table{
+			Name: "TestTable",
+			Columns: []Column{
+				Column{
+					ID:       "col1",
+					Name:     "Age",
+					Type:     "int",
+					Size:     []int{11},
+					Unsigned: true,
+					Metadata: metadata.Metadata{
+						PropertyID: "col1",
+					},
+				},
+			},
+			Metadata: metadata.Metadata{
+				PropertyID: "tbl1",
+			},
+		},
+	}
+
+	var signFlipTests = []struct {
+		To          []Table
+		From        []Table
+		DataLoss    []string
+		Description string
+	}{
+		{
+			To:          unsignedTables,
+			From:        signedTables,
+			DataLoss:    []string{"`Age` < 0"},
+			Description: "Tables Difference: Signed to Unsigned",
+		},
+		{
+			To:          signedTables,
+			From:        unsignedTables,
+			DataLoss:    []string{"`Age` > 2147483647"},
+			Description: "Tables Difference: Unsigned to Signed",
+		},
+	}
+
+	for _, test := range signFlipTests {
+		diffs, err := DiffTables(test.To, test.From, true, true)
+		if err != nil {
+			t.Errorf("%s Failed with error: %v", test.Description, err)
+			continue
+		}
+
+		if len(diffs.Slice) != 1 || diffs.Slice[0].Property != "Unsigned" {
+			t.Errorf("%s Failed. Expected a single Unsigned difference", test.Description)
+			util.DebugDump(diffs.Slice)
+			continue
+		}
+
+		pair := diffs.Slice[0].Value.(DiffPair)
+		conditions := pair.To.(Column).DataLossConditions(pair.From.(Column))
+
+		if !reflect.DeepEqual(conditions, test.DataLoss) {
+			t.Errorf("%s Failed. Data loss conditions are not correct", test.Description)
+			util.DebugDumpDiff(test.DataLoss, conditions)
+		}
+	}
+}
+
+func TestViews(t *testing.T) {
+
+	activeDogs := View{
+		ID:         "view1",
+		Name:       "active_dogs",
+		Definition: "select `dogs`.`id` AS `id` from `dogs`",
+		Metadata: metadata.Metadata{
+			PropertyID: "view1",
+		},
+	}
+
+	modifiedDogs := activeDogs
+	modifiedDogs.Definition = "select `dogs`.`id` AS `id` from `dogs` where (`dogs`.`active` = 1)"
+
+	oldView := View{
+		ID:         "view2",
+		Name:       "old_dogs",
+		Definition: "select `dogs`.`id` AS `id` from `dogs`",
+		Metadata: metadata.Metadata{
+			PropertyID: "view2",
+		},
+	}
+
+	dogNames := View{
+		ID:         "view3",
+		Name:       "dog_names",
+		Definition: "select `active_dogs`.`id` AS `id` from `active_dogs`",
+		Metadata: metadata.Metadata{
+			PropertyID: "view3",
+		},
+	}
+
+	var fromViews = []View{activeDogs, oldView}
+	var toViews = []View{dogNames, modifiedDogs}
+
+	// The new view selects from the modified view and must be created after it
+	var expectedDiffs = []Diff{
+		Diff{
+			Table:    "active_dogs",
+			Field:    "View",
+			Op:       Mod,
+			Property: "Definition",
+			Value: DiffPair{
+				From: activeDogs,
+				To:   modifiedDogs,
+			},
+			Metadata: modifiedDogs.Metadata,
+		},
+		Diff{
+			Table:    "dog_names",
+			Field:    "View",
+			Op:       Add,
+			Property: "dog_names",
+			Value:    dogNames,
+			Metadata: dogNames.Metadata,
+		},
+		Diff{
+			Table:    "old_dogs",
+			Field:    "View",
+			Op:       Del,
+			Property: "old_dogs",
+			Value:    oldView,
+			Metadata: oldView.Metadata,
+		},
+	}
+
+	diffs, err := DiffViews(toViews, fromViews, true, true)
+
+	if err != nil || !reflect.DeepEqual(diffs.Slice, expectedDiffs) {
+		t.Errorf("Views Difference Failed. Difference is not correct")
+
+		util.LogAttentionf("Views Difference Failed. Return object differs from expected object.")
+		util.DebugDumpDiff(expectedDiffs, diffs.Slice)
+	}
+}
+
+func TestRoutines(t *testing.T) {
+
+	auditTrigger := Routine{
+		ID:         "trigger1",
+		Name:       "dogs_audit",
+		Type:       RoutineTrigger,
+		Definition: "CREATE TRIGGER `dogs_audit` BEFORE UPDATE ON `dogs` FOR EACH ROW SET NEW.updated = NOW()",
+		Metadata: metadata.Metadata{
+			PropertyID: "trigger1",
+		},
+	}
+
+	modifiedTrigger := auditTrigger
+	modifiedTrigger.Definition = "CREATE TRIGGER `dogs_audit` BEFORE UPDATE ON `dogs` FOR EACH ROW SET NEW.updated = dog_now()"
+
+	// Only the DEFINER and whitespace differ, so the definitions are the same
+	insertTrigger := Routine{
+		ID:         "trigger2",
+		Name:       "dogs_insert",
+		Type:       RoutineTrigger,
+		Definition: "CREATE DEFINER=`root`@`localhost` TRIGGER `dogs_insert` BEFORE INSERT ON `dogs`\n  FOR EACH ROW SET NEW.created = NOW();",
+		Metadata: metadata.Metadata{
+			PropertyID: "trigger2",
+		},
+	}
+
+	yamlInsertTrigger := insertTrigger
+	yamlInsertTrigger.Definition = "CREATE TRIGGER `dogs_insert` BEFORE INSERT ON `dogs` FOR EACH ROW SET NEW.created = NOW()"
+
+	oldProcedure := Routine{
+		ID:         "procedure1",
+		Name:       "count_dogs",
+		Type:       RoutineProcedure,
+		Definition: "CREATE PROCEDURE `count_dogs`() SELECT COUNT(*) FROM `dogs`",
+		Metadata: metadata.Metadata{
+			PropertyID: "procedure1",
+		},
+	}
+
+	nowFunction := Routine{
+		ID:         "function1",
+		Name:       "dog_now",
+		Type:       RoutineFunction,
+		Definition: "CREATE FUNCTION `dog_now`() RETURNS datetime DETERMINISTIC RETURN NOW()",
+		Metadata: metadata.Metadata{
+			PropertyID: "function1",
+		},
+	}
+
+	var fromRoutines = []Routine{auditTrigger, insertTrigger, oldProcedure}
+	var toRoutines = []Routine{modifiedTrigger, yamlInsertTrigger, nowFunction}
+
+	// The function used by the modified trigger must be created before it
+	var expectedDiffs = []Diff{
+		Diff{
+			Table:    "count_dogs",
+			Field:    "Routine",
+			Op:       Del,
+			Property: "count_dogs",
+			Value:    oldProcedure,
+			Metadata: oldProcedure.Metadata,
+		},
+		Diff{
+			Table:    "dog_now",
+			Field:    "Routine",
+			Op:       Add,
+			Property: "dog_now",
+			Value:    nowFunction,
+			Metadata: nowFunction.Metadata,
+		},
+		Diff{
+			Table:    "dogs_audit",
+			Field:    "Routine",
+			Op:       Mod,
+			Property: "Definition",
+			Value: DiffPair{
+				From: auditTrigger,
+				To:   modifiedTrigger,
+			},
+			Metadata: modifiedTrigger.Metadata,
+		},
+	}
+
+	diffs, err := DiffRoutines(toRoutines, fromRoutines, true, true)
+
+	if err != nil || !reflect.DeepEqual(diffs.Slice, expectedDiffs) {
+		t.Errorf("Routines Difference Failed. Difference is not correct")
+
+		util.LogAttentionf("Routines Difference Failed. Return object differs from expected object.")
+		util.DebugDumpDiff(expectedDiffs, diffs.Slice)
+	}
+}
+
+func TestEvents(t *testing.T) {
+
+	// MySQL reports the STARTS time of a recurring event
+	dbCleanup := Event{
+		ID:           "event1",
+		Name:         "animals_cleanup",
+		Schedule:     "EVERY 1 DAY STARTS '2017-01-01 00:00:00'",
+		Status:       EventStatusEnable,
+		OnCompletion: EventNotPreserve,
+		Body:         "DELETE FROM `dogs` WHERE `active` = 0",
+		Metadata: metadata.Metadata{
+			PropertyID: "event1",
+		},
+	}
+
+	yamlCleanup := dbCleanup
+	yamlCleanup.Schedule = "EVERY 1 DAY"
+	yamlCleanup.Body = "DELETE FROM `dogs`\n    WHERE `active` = 0;"
+
+	dbPurge := Event{
+		ID:           "event2",
+		Name:         "animals_purge",
+		Schedule:     "EVERY 1 WEEK STARTS '2017-01-01 00:00:00'",
+		Status:       EventStatusEnable,
+		OnCompletion: EventNotPreserve,
+		Body:         "DELETE FROM `logs`",
+		Metadata: metadata.Metadata{
+			PropertyID: "event2",
+		},
+	}
+
+	yamlPurge := dbPurge
+	yamlPurge.Status = EventStatusDisable
+
+	dbArchive := Event{
+		ID:           "event3",
+		Name:         "animals_archive",
+		Schedule:     "AT '2017-06-01 12:00:00'",
+		Status:       EventStatusEnable,
+		OnCompletion: EventPreserve,
+		Body:         "DELETE FROM `archive`",
+		Metadata: metadata.Metadata{
+			PropertyID: "event3",
+		},
+	}
+
+	yamlReport := Event{
+		ID:           "event4",
+		Name:         "animals_report",
+		Schedule:     "EVERY 1 MONTH",
+		Status:       EventStatusEnable,
+		OnCompletion: EventNotPreserve,
+		Body:         "CALL `dogs_report`()",
+		Metadata: metadata.Metadata{
+			PropertyID: "event4",
+		},
+	}
+
+	var fromEvents = []Event{dbCleanup, dbPurge, dbArchive}
+	var toEvents = []Event{yamlCleanup, yamlPurge, yamlReport}
+
+	// Whitespace and an omitted STARTS time aren't differences
+	var expectedDiffs = []Diff{
+		Diff{
+			Table:    "animals_purge",
+			Field:    "Event",
+			Op:       Mod,
+			Property: "Status",
+			Value: DiffPair{
+				From: dbPurge,
+				To:   yamlPurge,
+			},
+			Metadata: yamlPurge.Metadata,
+		},
+		Diff{
+			Table:    "animals_report",
+			Field:    "Event",
+			Op:       Add,
+			Property: "animals_report",
+			Value:    yamlReport,
+			Metadata: yamlReport.Metadata,
+		},
+		Diff{
+			Table:    "animals_archive",
+			Field:    "Event",
+			Op:       Del,
+			Property: "animals_archive",
+			Value:    dbArchive,
+			Metadata: dbArchive.Metadata,
+		},
+	}
+
+	diffs, err := DiffEvents(toEvents, fromEvents, true, true)
+
+	if err != nil || !reflect.DeepEqual(diffs.Slice, expectedDiffs) {
+		t.Errorf("Events Difference Failed. Difference is not correct")
+
+		util.LogAttentionf("Events Difference Failed. Return object differs from expected object.")
+		util.DebugDumpDiff(expectedDiffs, diffs.Slice)
+	}
+}
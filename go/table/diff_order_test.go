@@ -607,6 +607,783 @@ var diffOrderTests = []DiffOrderTest{
 		ExpectFail:  false,
 		Description: "Index Recreation w/ Dependencies on Column Del and Add",
 	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    "order",
+				Field:    "ForeignKeys",
+				Op:       Add,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk_user",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk_user",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk_user",
+				},
+			},
+			{
+				Table:    "order",
+				Field:    "Columns",
+				Op:       Add,
+				Property: "user_id",
+				Value: Column{
+					ID:   "user_id",
+					Name: "user_id",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "user_id",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "user_id",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    "order",
+				Field:    "Columns",
+				Op:       Add,
+				Property: "user_id",
+				Value: Column{
+					ID:   "user_id",
+					Name: "user_id",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "user_id",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "user_id",
+				},
+			},
+			{
+				Table:    "order",
+				Field:    "ForeignKeys",
+				Op:       Add,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk_user",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk_user",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk_user",
+				},
+			},
+		},
+		Forward:     true,
+		ExpectFail:  false,
+		Description: "Foreign Key Add w/ Dependency on Column Add",
+	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Mod,
+				Property: "Type",
+				Value: DiffPair{
+					From: Column{
+						ID:   "body",
+						Name: "body",
+						Type: "varchar",
+						Size: []int{64},
+						Metadata: metadata.Metadata{
+							PropertyID: "body",
+						},
+					},
+					To: Column{
+						ID:   "body",
+						Name: "body",
+						Type: "text",
+						Metadata: metadata.Metadata{
+							PropertyID: "body",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "body",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "SecondaryIndexes",
+				Op:       Del,
+				Property: "idx_body",
+				Value: Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "sc1",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "SecondaryIndexes",
+				Op:       Add,
+				Property: "idx_body",
+				Value: Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Kind: IndexFullText,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "sc1",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    tblName,
+				Field:    "SecondaryIndexes",
+				Op:       Del,
+				Property: "idx_body",
+				Value: Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "sc1",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Mod,
+				Property: "Type",
+				Value: DiffPair{
+					From: Column{
+						ID:   "body",
+						Name: "body",
+						Type: "varchar",
+						Size: []int{64},
+						Metadata: metadata.Metadata{
+							PropertyID: "body",
+						},
+					},
+					To: Column{
+						ID:   "body",
+						Name: "body",
+						Type: "text",
+						Metadata: metadata.Metadata{
+							PropertyID: "body",
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "body",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "SecondaryIndexes",
+				Op:       Add,
+				Property: "idx_body",
+				Value: Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Kind: IndexFullText,
+					Metadata: metadata.Metadata{
+						PropertyID: "sc1",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "sc1",
+				},
+			},
+		},
+		Forward:     true,
+		ExpectFail:  false,
+		Description: "Index Kind Change w/ Dependency on Column Mod",
+	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "total",
+				Value: Column{
+					ID:         "total",
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Expression: "`price` * quantity",
+					Metadata: metadata.Metadata{
+						PropertyID: "total",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "total",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "quantity",
+				Value: Column{
+					ID:   "quantity",
+					Name: "quantity",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "quantity",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "quantity",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "quantity",
+				Value: Column{
+					ID:   "quantity",
+					Name: "quantity",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "quantity",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "quantity",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "total",
+				Value: Column{
+					ID:         "total",
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Expression: "`price` * quantity",
+					Metadata: metadata.Metadata{
+						PropertyID: "total",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "total",
+				},
+			},
+		},
+		Forward:     true,
+		ExpectFail:  false,
+		Description: "Generated Column Add w/ Dependency on Column Add",
+	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Del,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Del,
+				Property: "total",
+				Value: Column{
+					ID:         "total",
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Expression: "`price` * quantity",
+					Metadata: metadata.Metadata{
+						PropertyID: "total",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "total",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Del,
+				Property: "total",
+				Value: Column{
+					ID:         "total",
+					Name:       "total",
+					Type:       "int",
+					Size:       []int{11},
+					Expression: "`price` * quantity",
+					Metadata: metadata.Metadata{
+						PropertyID: "total",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "total",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Del,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+		},
+		Forward:     true,
+		ExpectFail:  false,
+		Description: "Column Del w/ Dependency on Generated Column Del",
+	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    tblName,
+				Field:    "CheckConstraints",
+				Op:       Add,
+				Property: "chk_price",
+				Value: CheckConstraint{
+					ID:         "chk_price",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk_price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "chk_price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Add,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "CheckConstraints",
+				Op:       Add,
+				Property: "chk_price",
+				Value: CheckConstraint{
+					ID:         "chk_price",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk_price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "chk_price",
+				},
+			},
+		},
+		Forward:     true,
+		ExpectFail:  false,
+		Description: "Check Constraint Add w/ Dependency on Column Add",
+	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Del,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "CheckConstraints",
+				Op:       Del,
+				Property: "chk_price",
+				Value: CheckConstraint{
+					ID:         "chk_price",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk_price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "chk_price",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    tblName,
+				Field:    "CheckConstraints",
+				Op:       Del,
+				Property: "chk_price",
+				Value: CheckConstraint{
+					ID:         "chk_price",
+					Name:       "chk_price",
+					Expression: "`price` > 0",
+					Metadata: metadata.Metadata{
+						PropertyID: "chk_price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "chk_price",
+				},
+			},
+			{
+				Table:    tblName,
+				Field:    "Columns",
+				Op:       Del,
+				Property: "price",
+				Value: Column{
+					ID:   "price",
+					Name: "price",
+					Type: "int",
+					Size: []int{11},
+					Metadata: metadata.Metadata{
+						PropertyID: "price",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "price",
+				},
+			},
+		},
+		Forward:     true,
+		ExpectFail:  false,
+		Description: "Column Del w/ Dependency on Check Constraint Del",
+	},
+}
+
+var tableDiffOrderTests = []DiffOrderTest{
+
+	{
+		Generated: []Diff{
+			{
+				Table:    "order",
+				Field:    "ForeignKeys",
+				Op:       Add,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk_user",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk_user",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk_user",
+				},
+			},
+			{
+				Table:    "user",
+				Field:    "*",
+				Op:       Add,
+				Property: "*",
+				Value: Table{
+					Name: "user",
+					Columns: []Column{
+						{
+							ID:   "id",
+							Name: "id",
+							Type: "int",
+							Size: []int{11},
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "user",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    "user",
+				Field:    "*",
+				Op:       Add,
+				Property: "*",
+				Value: Table{
+					Name: "user",
+					Columns: []Column{
+						{
+							ID:   "id",
+							Name: "id",
+							Type: "int",
+							Size: []int{11},
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "user",
+				},
+			},
+			{
+				Table:    "order",
+				Field:    "ForeignKeys",
+				Op:       Add,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk_user",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk_user",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk_user",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Foreign Key Add w/ Dependency on Referenced Table Create",
+	},
+
+	{
+		Generated: []Diff{
+			{
+				Table:    "user",
+				Field:    "*",
+				Op:       Del,
+				Property: "*",
+				Value: Table{
+					Name: "user",
+					Columns: []Column{
+						{
+							ID:   "id",
+							Name: "id",
+							Type: "int",
+							Size: []int{11},
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "user",
+				},
+			},
+			{
+				Table:    "order",
+				Field:    "ForeignKeys",
+				Op:       Del,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk_user",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk_user",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk_user",
+				},
+			},
+		},
+		Sorted: []Diff{
+			{
+				Table:    "order",
+				Field:    "ForeignKeys",
+				Op:       Del,
+				Property: "fk_user",
+				Value: ForeignKey{
+					ID:               "fk_user",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					Metadata: metadata.Metadata{
+						PropertyID: "fk_user",
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "fk_user",
+				},
+			},
+			{
+				Table:    "user",
+				Field:    "*",
+				Op:       Del,
+				Property: "*",
+				Value: Table{
+					Name: "user",
+					Columns: []Column{
+						{
+							ID:   "id",
+							Name: "id",
+							Type: "int",
+							Size: []int{11},
+						},
+					},
+				},
+				Metadata: metadata.Metadata{
+					PropertyID: "user",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "Referenced Table Drop w/ Dependency on Foreign Key Drop",
+	},
 }
 
 func TestDiffOrder(t *testing.T) {
@@ -651,3 +1428,22 @@ func TestDiffOrder(t *testing.T) {
 
 	}
 }
+
+func TestTableDiffOrder(t *testing.T) {
+	util.VerboseOverrideSet(true)
+	for _, test := range tableDiffOrderTests {
+
+		util.LogAttentionf("Testing: %s", test.Description)
+		sorted, err := orderTableDiffs(Differences{Slice: test.Generated})
+
+		if err != nil && !test.ExpectFail {
+			t.Errorf("%s Sort Failed with ERROR", test.Description)
+			util.LogError(err)
+		}
+
+		if !test.ExpectFail && !reflect.DeepEqual(sorted.Slice, test.Sorted) {
+			t.Errorf("%s Failed. Sort is not correct", test.Description)
+			util.DebugDumpDiffDetail(test.Sorted, sorted.Slice, "Expected", "Received")
+		}
+	}
+}
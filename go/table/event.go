@@ -0,0 +1,192 @@
+package table
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/config"
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// Event Status and Completion
+const (
+	EventStatusEnable         = "ENABLE"
+	EventStatusDisable        = "DISABLE"
+	EventStatusDisableOnSlave = "DISABLE ON SLAVE"
+	EventNotPreserve          = "NOT PRESERVE"
+	EventPreserve             = "PRESERVE"
+)
+
+// EventFileSuffix The filename suffix which identifies a YAML file as an Event
+const EventFileSuffix = ".event.yml"
+
+// eventStartsPattern Matches the STARTS clause of an Event schedule
+var eventStartsPattern = regexp.MustCompile("(?i)\\s+STARTS\\s+'[^']*'")
+
+// Events Helper type for a slice of Event structs
+type Events []Event
+
+// Event Stores the schedule, status and body of a MySQL Event parsed from YAML
+// or from a MySQL SHOW CREATE EVENT statement
+type Event struct {
+	ID           string `yaml:"id"`
+	Name         string
+	Schedule     string
+	Status       string `yaml:",omitempty"`
+	OnCompletion string `yaml:",omitempty"`
+	Comment      string `yaml:",omitempty"`
+	Body         string
+
+	Namespace Namespace         `yaml:"-"`
+	Filename  string            `yaml:"-"`
+	Metadata  metadata.Metadata `yaml:"-"`
+}
+
+// SetNamespace Assign the Event to the Schema Namespace with a TablePrefix
+// matching the name of the Event
+func (e *Event) SetNamespace(conf config.Config) (err error) {
+	e.Namespace = NewNamespace(findNamespace(conf, e.Name), e.Name)
+	return err
+}
+
+// HasStartTime Returns true if the schedule of the Event defines when it STARTS
+func (e Event) HasStartTime() bool {
+	return eventStartsPattern.MatchString(e.Schedule)
+}
+
+// Normalised Returns a copy of the Event with the whitespace in the Schedule
+// and Body collapsed so that Events can be compared textually.  MySQL sets the
+// STARTS time of a recurring Event when it is created, so unless withStartTime is
+// set, the STARTS clause is removed from the Schedule.
+func (e Event) Normalised(withStartTime bool) Event {
+	event := e
+
+	if !withStartTime {
+		event.Schedule = eventStartsPattern.ReplaceAllString(event.Schedule, "")
+	}
+
+	event.Schedule = strings.Join(strings.Fields(event.Schedule), " ")
+	event.Body = strings.Join(strings.Fields(strings.TrimSuffix(strings.TrimSpace(event.Body), ";")), " ")
+
+	return event
+}
+
+// clausesSQL Formats the schedule, completion, rename, status, comment and body
+// clauses shared by the CREATE and ALTER EVENT statements
+func (e Event) clausesSQL(rename string) string {
+	sql := fmt.Sprintf("ON SCHEDULE %s", e.Schedule)
+
+	if len(e.OnCompletion) > 0 {
+		sql += fmt.Sprintf(" ON COMPLETION %s", e.OnCompletion)
+	}
+
+	if len(rename) > 0 {
+		sql += fmt.Sprintf(" RENAME TO `%s`", rename)
+	}
+
+	if len(e.Status) > 0 {
+		sql += " " + e.Status
+	}
+
+	if len(e.Comment) > 0 {
+		sql += fmt.Sprintf(" COMMENT '%s'", strings.Replace(e.Comment, "'", "''", -1))
+	}
+
+	sql += fmt.Sprintf(" DO %s", e.Body)
+
+	return sql
+}
+
+// ToSQL Formats the Event into its CREATE EVENT SQL representation
+func (e Event) ToSQL() string {
+	return fmt.Sprintf("CREATE EVENT `%s` %s", e.Name, e.clausesSQL(""))
+}
+
+// AlterSQL Formats the Event into an ALTER EVENT statement which updates the
+// Event named by the from parameter, renaming it if necessary
+func (e Event) AlterSQL(from string) string {
+	rename := ""
+	if from != e.Name {
+		rename = e.Name
+	}
+	return fmt.Sprintf("ALTER EVENT `%s` %s", from, e.clausesSQL(rename))
+}
+
+// DropSQL Formats the Event into its DROP EVENT SQL representation
+func (e Event) DropSQL() string {
+	return fmt.Sprintf("DROP EVENT `%s`", e.Name)
+}
+
+// LoadDBMetadata Populate the Metadata for this event with data from the database
+func (e *Event) LoadDBMetadata() (err error) {
+	var mds []metadata.Metadata
+
+	mds, err = metadata.LoadAllTableMetadata(e.Name)
+
+	// No metadata for this event is a valid state
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, md := range mds {
+		if md.ParentID == "" && md.Type == "Event" {
+			e.Metadata = md
+		}
+	}
+
+	return err
+}
+
+// SyncDBMetadata Helper function to insert new Metadata and retrieves existing Metadata from the DB
+func (e *Event) SyncDBMetadata() (err error) {
+
+	err = e.LoadDBMetadata()
+	if err != nil {
+		return err
+	}
+
+	// An Event which hasn't been recorded in the DB needs to be inserted
+	if e.Metadata.MDID < 1 {
+		if len(e.Metadata.Name) == 0 {
+			return fmt.Errorf("Cannot create or find Metadata without a name")
+		}
+		err = e.Metadata.Insert()
+	}
+
+	util.ErrorCheckf(err, "Failed to sync Metadata for Event: [%s]", e.Name)
+
+	return err
+}
+
+// GeneratePropertyIDs Generate a PropertyID for the Event if it doesn't have one set.
+func (e *Event) GeneratePropertyIDs() error {
+	if e.Metadata.PropertyID == "" {
+		eventID := strings.ToLower(e.Metadata.Name)
+
+		if eventID == "" {
+			eventID = strings.ToLower(e.Name)
+		}
+
+		e.ID = eventID
+		e.Metadata.PropertyID = eventID
+
+	} else {
+		e.ID = e.Metadata.PropertyID
+	}
+
+	return nil
+}
+
+// InsertMetadata Insert the Event Metadata into the Managment Metadata table
+func (e *Event) InsertMetadata() (err error) {
+	err = e.Metadata.OnCreate()
+	util.ErrorCheck(err)
+	return err
+}
@@ -0,0 +1,95 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+)
+
+// ForeignKey Stores the properties for a Foreign Key constraint
+type ForeignKey struct {
+	ID               string `yaml:"id"`
+	Name             string
+	Columns          []string `yaml:",flow"`
+	ReferenceTable   string
+	ReferenceColumns []string          `yaml:",flow"`
+	OnDelete         string            `yaml:",omitempty"`
+	OnUpdate         string            `yaml:",omitempty"`
+	Metadata         metadata.Metadata `yaml:"-"`
+}
+
+// IsValid Return if the foreign key references any columns
+func (fk ForeignKey) IsValid() bool {
+	return len(fk.Columns) > 0 && len(fk.ReferenceColumns) > 0 && fk.ReferenceTable != ""
+}
+
+// UsesColumn Returns true if the column parameter is one of the constrained columns
+func (fk ForeignKey) UsesColumn(column string) bool {
+	for _, col := range fk.Columns {
+		if col == column {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferencesColumn Returns true if the foreign key references the column in the table parameter
+func (fk ForeignKey) ReferencesColumn(tableName string, column string) bool {
+	if fk.ReferenceTable != tableName {
+		return false
+	}
+	for _, col := range fk.ReferenceColumns {
+		if col == column {
+			return true
+		}
+	}
+	return false
+}
+
+// ToSQL Formats the foreign key into its SQL representation
+func (fk ForeignKey) ToSQL() string {
+
+	if !fk.IsValid() {
+		return ""
+	}
+
+	sql := fmt.Sprintf("CONSTRAINT `%s` FOREIGN KEY %s REFERENCES `%s` %s",
+		fk.Name,
+		quoteColumns(fk.Columns),
+		fk.ReferenceTable,
+		quoteColumns(fk.ReferenceColumns),
+	)
+
+	if len(fk.OnDelete) > 0 {
+		sql += fmt.Sprintf(" ON DELETE %s", fk.OnDelete)
+	}
+
+	if len(fk.OnUpdate) > 0 {
+		sql += fmt.Sprintf(" ON UPDATE %s", fk.OnUpdate)
+	}
+
+	return sql
+}
+
+// NormalizeReferenceAction Returns the referential action as it is reported by MySQL.
+// RESTRICT and NO ACTION are the default action, which SHOW CREATE TABLE omits, so they
+// are normalized to an empty action to prevent false differences.
+func NormalizeReferenceAction(action string) string {
+	action = strings.ToUpper(strings.TrimSpace(action))
+	if action == "RESTRICT" || action == "NO ACTION" {
+		return ""
+	}
+	return action
+}
+
+// quoteColumns Formats a list of column names into a bracketed and `quoted` list
+func quoteColumns(columns []string) string {
+	quoted := []string{}
+
+	for _, col := range columns {
+		quoted = append(quoted, fmt.Sprintf("`%s`", col))
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(quoted, ","))
+}
@@ -11,6 +11,12 @@ const (
 	PrimaryKey = "PrimaryKey"
 )
 
+// Index Kinds.  A regular index has an empty Kind.
+const (
+	IndexFullText = "FULLTEXT"
+	IndexSpatial  = "SPATIAL"
+)
+
 // IndexColumn Stores the properties of an index field
 type IndexColumn struct {
 	Name   string
@@ -32,6 +38,9 @@ type Index struct {
 	Columns   []IndexColumn
 	IsPrimary bool              `yaml:",omitempty"`
 	IsUnique  bool              `yaml:",omitempty"`
+	Kind      string            `yaml:",omitempty"`
+	Using     string            `yaml:",omitempty"`
+	Parser    string            `yaml:",omitempty"`
 	Metadata  metadata.Metadata `yaml:"-"`
 }
 
@@ -51,14 +60,36 @@ func (i Index) ToSQL() string {
 	if i.IsPrimary {
 		name = "PRIMARY KEY"
 	} else {
-		isUnique := ""
-		if i.IsUnique {
-			isUnique = "UNIQUE"
-		}
-		name = fmt.Sprintf("%s KEY `%s`", isUnique, i.Name)
+		name = fmt.Sprintf("%s KEY `%s`", i.KindSQL(), i.Name)
+	}
+
+	return fmt.Sprintf("%s %s%s", name, i.ColumnsSQL(), i.OptionsSQL())
+}
+
+// KindSQL Returns the SQL keyword which qualifies the type of the index
+func (i Index) KindSQL() string {
+	if len(i.Kind) > 0 {
+		return i.Kind
+	}
+	if i.IsUnique {
+		return "UNIQUE"
+	}
+	return ""
+}
+
+// OptionsSQL Formats the index type and parser options into their SQL representation
+func (i Index) OptionsSQL() string {
+	options := ""
+
+	if len(i.Using) > 0 {
+		options += fmt.Sprintf(" USING %s", i.Using)
+	}
+
+	if len(i.Parser) > 0 {
+		options += fmt.Sprintf(" WITH PARSER `%s`", i.Parser)
 	}
 
-	return fmt.Sprintf("%s %s", name, i.ColumnsSQL())
+	return options
 }
 
 // ColumnsSQL Formats the Index columns into the appropriate SQL representation
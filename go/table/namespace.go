@@ -44,6 +44,18 @@ func NewNamespace(ns *config.SchemaNamespace, tableName string) (tableNS Namespa
 
 }
 
+// findNamespace Search the configured Schema Namespaces for the Namespace
+// with a TablePrefix matching name
+func findNamespace(conf config.Config, name string) (ns *config.SchemaNamespace) {
+	for _, sns := range conf.Project.Schema.Namespaces {
+		if strings.HasPrefix(name, sns.TablePrefix) {
+			ns = &sns
+			break
+		}
+	}
+	return ns
+}
+
 // SetTableFilename Sets the expected table filename format ahead of searching for existing files.
 func (tn *Namespace) SetTableFilename(fileformat string) {
 	tn.TableFilename = strings.Replace(fileformat, "<table>", tn.TableName, 1)
@@ -0,0 +1,138 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Partitioning Types
+const (
+	PartitionRange = "RANGE"
+	PartitionList  = "LIST"
+	PartitionHash  = "HASH"
+	PartitionKey   = "KEY"
+)
+
+// Partition Stores the properties of a named partition.  Values contains the
+// bracketed values of a RANGE or LIST partition, or MAXVALUE.
+type Partition struct {
+	Name   string
+	Values string `yaml:",omitempty"`
+}
+
+// Partitioning Stores the partitioning scheme of a table
+type Partitioning struct {
+	Type       string      `yaml:",omitempty"`
+	Linear     bool        `yaml:",omitempty"`
+	Columns    bool        `yaml:",omitempty"`
+	Expression string      `yaml:",omitempty"`
+	Count      int         `yaml:",omitempty"`
+	Partitions []Partition `yaml:",omitempty"`
+}
+
+// IsValid Return if the table is partitioned
+func (p Partitioning) IsValid() bool {
+	return len(p.Type) > 0
+}
+
+// HasValues Returns true if the partitions of this scheme define VALUES
+func (p Partitioning) HasValues() bool {
+	return p.Type == PartitionRange || p.Type == PartitionList
+}
+
+// GetPartition Returns the partition with a matching name
+func (p Partitioning) GetPartition(name string) (partition Partition, found bool) {
+	for _, part := range p.Partitions {
+		if part.Name == name {
+			return part, true
+		}
+	}
+	return partition, false
+}
+
+// HasSameScheme Returns true if the partitioning type, expression and the partition
+// count of a HASH or KEY partitioned table are the same as the other parameter
+func (p Partitioning) HasSameScheme(other Partitioning) bool {
+	if p.Type != other.Type || p.Linear != other.Linear || p.Columns != other.Columns || p.Expression != other.Expression {
+		return false
+	}
+
+	if !p.HasValues() {
+		if p.Count != other.Count || len(p.Partitions) != len(other.Partitions) {
+			return false
+		}
+		for i, part := range p.Partitions {
+			if part.Name != other.Partitions[i].Name {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// WithPartitions Returns a copy of the partitioning scheme containing only the partitions parameter
+func (p Partitioning) WithPartitions(partitions ...Partition) Partitioning {
+	scheme := p
+	scheme.Partitions = partitions
+	return scheme
+}
+
+// SchemeSQL Formats the partitioning type and expression into its SQL representation
+func (p Partitioning) SchemeSQL() string {
+	scheme := p.Type
+
+	if p.Linear {
+		scheme = "LINEAR " + scheme
+	}
+
+	if p.Columns {
+		scheme += " COLUMNS"
+	}
+
+	return fmt.Sprintf("PARTITION BY %s (%s)", scheme, p.Expression)
+}
+
+// ToSQL Formats the partitioning into its SQL representation
+func (p Partitioning) ToSQL() string {
+
+	if !p.IsValid() {
+		return ""
+	}
+
+	sql := p.SchemeSQL()
+
+	if p.Count > 0 && len(p.Partitions) == 0 {
+		sql += fmt.Sprintf(" PARTITIONS %d", p.Count)
+	}
+
+	if len(p.Partitions) > 0 {
+		sql += " " + p.PartitionsSQL(p.Partitions)
+	}
+
+	return sql
+}
+
+// PartitionsSQL Formats the partitions parameter into a bracketed SQL list of partition definitions
+func (p Partitioning) PartitionsSQL(partitions []Partition) string {
+	defs := []string{}
+
+	for _, part := range partitions {
+		defs = append(defs, p.PartitionSQL(part))
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(defs, ", "))
+}
+
+// PartitionSQL Formats the partition parameter into its SQL definition
+func (p Partitioning) PartitionSQL(part Partition) string {
+	sql := fmt.Sprintf("PARTITION `%s`", part.Name)
+
+	switch p.Type {
+	case PartitionRange:
+		sql += fmt.Sprintf(" VALUES LESS THAN %s", part.Values)
+	case PartitionList:
+		sql += fmt.Sprintf(" VALUES IN %s", part.Values)
+	}
+
+	return sql
+}
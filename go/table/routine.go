@@ -0,0 +1,201 @@
+package table
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// Routine Types
+const (
+	RoutineTrigger   = "TRIGGER"
+	RoutineProcedure = "PROCEDURE"
+	RoutineFunction  = "FUNCTION"
+)
+
+// RoutineTypes The supported Routine Types in the order that they are created
+var RoutineTypes = []string{RoutineFunction, RoutineProcedure, RoutineTrigger}
+
+// definerPattern Matches the DEFINER clause of a CREATE statement
+var definerPattern = regexp.MustCompile("(?i)\\s+DEFINER\\s*=\\s*\\S+")
+
+// Routines Helper type for a slice of Routine structs
+type Routines []Routine
+
+// Routine Stores a Trigger, Stored Procedure or Function parsed from YAML, an
+// SQL file or from a MySQL SHOW CREATE statement.  The Definition contains the
+// complete CREATE statement.
+type Routine struct {
+	ID         string `yaml:"id"`
+	Name       string
+	Type       string `yaml:",omitempty"`
+	Definition string
+
+	Filename string            `yaml:"-"`
+	Metadata metadata.Metadata `yaml:"-"`
+}
+
+// RoutineFileSuffix Returns the filename suffix which identifies a YAML file as
+// a Routine of the routineType parameter
+func RoutineFileSuffix(routineType string) string {
+	return fmt.Sprintf(".%s.yml", strings.ToLower(routineType))
+}
+
+// MetadataType Returns the Metadata Type used for the Routine
+func (r Routine) MetadataType() string {
+	return strings.Title(strings.ToLower(r.Type))
+}
+
+// ParseDefinition Cleans the Definition of any DELIMITER statements and the
+// DEFINER clause, and extracts the Type and Name of the Routine from it.
+func (r *Routine) ParseDefinition() (err error) {
+	delimiter := ";"
+	lines := []string{}
+
+	for _, line := range strings.Split(r.Definition, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(strings.ToUpper(trimmed), "DELIMITER ") {
+			if fields := strings.Fields(trimmed); len(fields) > 1 && fields[1] != ";" {
+				delimiter = fields[1]
+			}
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	definition := strings.TrimSpace(strings.Join(lines, "\n"))
+	definition = strings.TrimSpace(strings.TrimSuffix(definition, delimiter))
+	definition = definerPattern.ReplaceAllString(definition, "")
+	r.Definition = definition
+
+	// Format: CREATE <TYPE> [IF NOT EXISTS] `<NAME>` ...
+	fields := strings.Fields(definition)
+	if len(fields) < 3 || strings.ToUpper(fields[0]) != "CREATE" {
+		return fmt.Errorf("Invalid Routine Definition: Not a CREATE statement: [%s]", definition)
+	}
+
+	routineType := strings.ToUpper(fields[1])
+	if !util.StringInArray(routineType, RoutineTypes) {
+		return fmt.Errorf("Invalid Routine Definition: Unsupported type: [%s]", fields[1])
+	}
+
+	name := fields[2]
+	if strings.ToUpper(name) == "IF" && len(fields) > 5 {
+		name = fields[5]
+	}
+
+	// Strip any parameter list, schema name and quoting
+	if pos := strings.Index(name, "("); pos != -1 {
+		name = name[:pos]
+	}
+	if pos := strings.LastIndex(name, "`.`"); pos != -1 {
+		name = name[pos+2:]
+	}
+	name = strings.Trim(name, "`")
+
+	if len(name) == 0 {
+		return fmt.Errorf("Invalid Routine Definition: No name defined: [%s]", definition)
+	}
+
+	if len(r.Type) > 0 && r.Type != routineType {
+		return fmt.Errorf("Invalid Routine Definition: Expected a %s but found a %s: [%s]", r.Type, routineType, name)
+	}
+
+	r.Type = routineType
+	r.Name = name
+
+	return err
+}
+
+// NormalisedDefinition Returns the Definition with the DEFINER clause removed and all
+// whitespace collapsed so that Definitions can be compared textually
+func (r Routine) NormalisedDefinition() string {
+	definition := definerPattern.ReplaceAllString(r.Definition, "")
+	definition = strings.TrimSuffix(strings.TrimSpace(definition), ";")
+	return strings.Join(strings.Fields(definition), " ")
+}
+
+// ToSQL Formats the Routine into its CREATE SQL representation
+func (r Routine) ToSQL() string {
+	return definerPattern.ReplaceAllString(strings.TrimSpace(r.Definition), "")
+}
+
+// DropSQL Formats the Routine into its DROP SQL representation
+func (r Routine) DropSQL() string {
+	return fmt.Sprintf("DROP %s IF EXISTS `%s`", r.Type, r.Name)
+}
+
+// LoadDBMetadata Populate the Metadata for this routine with data from the database
+func (r *Routine) LoadDBMetadata() (err error) {
+	var mds []metadata.Metadata
+
+	mds, err = metadata.LoadAllTableMetadata(r.Name)
+
+	// No metadata for this routine is a valid state
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, md := range mds {
+		if md.ParentID == "" && md.Type == r.MetadataType() {
+			r.Metadata = md
+		}
+	}
+
+	return err
+}
+
+// SyncDBMetadata Helper function to insert new Metadata and retrieves existing Metadata from the DB
+func (r *Routine) SyncDBMetadata() (err error) {
+
+	err = r.LoadDBMetadata()
+	if err != nil {
+		return err
+	}
+
+	// A Routine which hasn't been recorded in the DB needs to be inserted
+	if r.Metadata.MDID < 1 {
+		if len(r.Metadata.Name) == 0 {
+			return fmt.Errorf("Cannot create or find Metadata without a name")
+		}
+		err = r.Metadata.Insert()
+	}
+
+	util.ErrorCheckf(err, "Failed to sync Metadata for %s: [%s]", r.MetadataType(), r.Name)
+
+	return err
+}
+
+// GeneratePropertyIDs Generate a PropertyID for the Routine if it doesn't have one set.
+func (r *Routine) GeneratePropertyIDs() error {
+	if r.Metadata.PropertyID == "" {
+		routineID := strings.ToLower(r.Metadata.Name)
+
+		if routineID == "" {
+			routineID = strings.ToLower(r.Name)
+		}
+
+		r.ID = routineID
+		r.Metadata.PropertyID = routineID
+
+	} else {
+		r.ID = r.Metadata.PropertyID
+	}
+
+	return nil
+}
+
+// InsertMetadata Insert the Routine Metadata into the Managment Metadata table
+func (r *Routine) InsertMetadata() (err error) {
+	err = r.Metadata.OnCreate()
+	util.ErrorCheck(err)
+	return err
+}
@@ -23,9 +23,13 @@ type Table struct {
 	CharSet          string
 	RowFormat        string `yaml:",omitempty"`
 	Collation        string `yaml:",omitempty"`
+	Comment          string `yaml:",omitempty"`
 	Columns          []Column
-	PrimaryIndex     Index   `yaml:",omitempty"`
-	SecondaryIndexes []Index `yaml:",omitempty"`
+	PrimaryIndex     Index             `yaml:",omitempty"`
+	SecondaryIndexes []Index           `yaml:",omitempty"`
+	ForeignKeys      []ForeignKey      `yaml:",omitempty"`
+	CheckConstraints []CheckConstraint `yaml:",omitempty"`
+	Partitioning     Partitioning      `yaml:",omitempty"`
 
 	Namespace Namespace         `yaml:"-"`
 	Filename  string            `yaml:"-"`
@@ -36,17 +40,7 @@ type Table struct {
 // into an underscore delimited namespace
 func (t *Table) SetNamespace(conf config.Config) (err error) {
 
-	var ns *config.SchemaNamespace
-
-	// Search for configured Schema Namespaces
-	for _, sns := range conf.Project.Schema.Namespaces {
-		if strings.HasPrefix(t.Name, sns.TablePrefix) {
-			ns = &sns
-			break
-		}
-	}
-
-	t.Namespace = NewNamespace(ns, t.Name)
+	t.Namespace = NewNamespace(findNamespace(conf, t.Name), t.Name)
 	return err
 }
 
@@ -84,7 +78,13 @@ func (t *Table) LoadDBMetadata() (err error) {
 		// Columns
 		if md.Type == "Column" {
 			for i := 0; i < len(t.Columns); i++ {
-				if md.Name == t.Columns[i].Name {
+				// A column with a PropertyID is matched on it so that a renamed
+				// column keeps the Metadata recorded under its previous name
+				if t.Columns[i].Metadata.PropertyID != "" {
+					if md.PropertyID == t.Columns[i].Metadata.PropertyID {
+						t.Columns[i].Metadata = md
+					}
+				} else if md.Name == t.Columns[i].Name {
 					t.Columns[i].Metadata = md
 				}
 			}
@@ -103,6 +103,24 @@ func (t *Table) LoadDBMetadata() (err error) {
 				}
 			}
 		}
+
+		// Foreign Keys
+		if md.Type == "ForeignKey" {
+			for i := 0; i < len(t.ForeignKeys); i++ {
+				if md.Name == t.ForeignKeys[i].Name {
+					t.ForeignKeys[i].Metadata = md
+				}
+			}
+		}
+
+		// Check Constraints
+		if md.Type == "CheckConstraint" {
+			for i := 0; i < len(t.CheckConstraints); i++ {
+				if md.Name == t.CheckConstraints[i].Name {
+					t.CheckConstraints[i].Metadata = md
+				}
+			}
+		}
 	}
 
 	return err
@@ -140,7 +158,7 @@ func (t *Table) SyncDBMetadata() (err error) {
 		return nil
 	}
 
-	// Process the Table, then Columns, then PrimaryKey, and finally the Indexes
+	// Process the Table, then Columns, then PrimaryKey, then the Indexes, then the Foreign Keys, and finally the Check Constraints
 	err = syncDB(&t.Metadata)
 
 	if util.ErrorCheckf(err, "Failed to sync Metadata for Table: [%s]", t.Name) {
@@ -169,6 +187,22 @@ func (t *Table) SyncDBMetadata() (err error) {
 		}
 	}
 
+	for i := 0; i < len(t.ForeignKeys); i++ {
+		err = syncDB(&t.ForeignKeys[i].Metadata)
+
+		if util.ErrorCheckf(err, "Failed to sync Metadata for Table: [%s] Foreign Key: [%s]", t.Name, t.ForeignKeys[i].Name) {
+			return err
+		}
+	}
+
+	for i := 0; i < len(t.CheckConstraints); i++ {
+		err = syncDB(&t.CheckConstraints[i].Metadata)
+
+		if util.ErrorCheckf(err, "Failed to sync Metadata for Table: [%s] Check Constraint: [%s]", t.Name, t.CheckConstraints[i].Name) {
+			return err
+		}
+	}
+
 	return err
 }
 
@@ -242,6 +276,50 @@ func (t *Table) GeneratePropertyIDs() error {
 		}
 	}
 
+	// Foreign Keys
+	for i := 0; i < len(t.ForeignKeys); i++ {
+		fk := &t.ForeignKeys[i]
+		if fk.Metadata.PropertyID == "" {
+			fkID := fk.ID
+			if fkID == "" {
+				fkID = strings.ToLower(fk.Metadata.Name)
+			}
+
+			if fkID == "" {
+				fkID = strings.ToLower(fk.Name)
+			}
+			fk.ID = fkID
+			fk.Metadata.PropertyID = fkID
+			fk.Metadata.ParentID = tableID
+
+		} else {
+			fk.ID = fk.Metadata.PropertyID
+			fk.Metadata.ParentID = tableID
+		}
+	}
+
+	// Check Constraints
+	for i := 0; i < len(t.CheckConstraints); i++ {
+		chk := &t.CheckConstraints[i]
+		if chk.Metadata.PropertyID == "" {
+			chkID := chk.ID
+			if chkID == "" {
+				chkID = strings.ToLower(chk.Metadata.Name)
+			}
+
+			if chkID == "" {
+				chkID = strings.ToLower(chk.Name)
+			}
+			chk.ID = chkID
+			chk.Metadata.PropertyID = chkID
+			chk.Metadata.ParentID = tableID
+
+		} else {
+			chk.ID = chk.Metadata.PropertyID
+			chk.Metadata.ParentID = tableID
+		}
+	}
+
 	return nil
 }
 
@@ -275,5 +353,21 @@ func (t *Table) InsertMetadata() (err error) {
 		}
 	}
 
+	// Foreign Keys
+	for _, fk := range t.ForeignKeys {
+		err = fk.Metadata.OnCreate()
+		if util.ErrorCheck(err) {
+			return err
+		}
+	}
+
+	// Check Constraints
+	for _, chk := range t.CheckConstraints {
+		err = chk.Metadata.OnCreate()
+		if util.ErrorCheck(err) {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,137 @@
+package table
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/freneticmonkey/migrate/go/metadata"
+	"github.com/freneticmonkey/migrate/go/util"
+)
+
+// View Algorithms and SQL Security
+const (
+	ViewAlgorithmUndefined = "UNDEFINED"
+	ViewSecurityDefiner    = "DEFINER"
+)
+
+// ViewFileSuffix The filename suffix which identifies a YAML file as a View
+const ViewFileSuffix = ".view.yml"
+
+// Views Helper type for a slice of View structs
+type Views []View
+
+// View Stores the fields and properties representing a View parsed from YAML
+// or from a MySQL CREATE VIEW statement
+type View struct {
+	ID          string `yaml:"id"`
+	Name        string
+	Definition  string
+	Algorithm   string `yaml:",omitempty"`
+	Security    string `yaml:",omitempty"`
+	CheckOption string `yaml:",omitempty"`
+
+	Filename string            `yaml:"-"`
+	Metadata metadata.Metadata `yaml:"-"`
+}
+
+// UsesTable Returns true if the view definition selects from the table or view
+// named by the parameter
+func (v View) UsesTable(name string) bool {
+	if name == v.Name {
+		return false
+	}
+	return util.StringInArray(name, expressionColumns(v.Definition))
+}
+
+// ToSQL Formats the view into its CREATE OR REPLACE VIEW SQL representation
+func (v View) ToSQL() string {
+	sql := "CREATE OR REPLACE"
+
+	if len(v.Algorithm) > 0 {
+		sql += fmt.Sprintf(" ALGORITHM=%s", v.Algorithm)
+	}
+
+	if len(v.Security) > 0 {
+		sql += fmt.Sprintf(" SQL SECURITY %s", v.Security)
+	}
+
+	sql += fmt.Sprintf(" VIEW `%s` AS %s", v.Name, v.Definition)
+
+	if len(v.CheckOption) > 0 {
+		sql += fmt.Sprintf(" WITH %s CHECK OPTION", v.CheckOption)
+	}
+
+	return sql
+}
+
+// LoadDBMetadata Populate the Metadata for this view with data from the database
+func (v *View) LoadDBMetadata() (err error) {
+	var mds []metadata.Metadata
+
+	mds, err = metadata.LoadAllTableMetadata(v.Name)
+
+	// No metadata for this view is a valid state
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, md := range mds {
+		if md.ParentID == "" && md.Type == "View" {
+			v.Metadata = md
+		}
+	}
+
+	return err
+}
+
+// SyncDBMetadata Helper function to insert new Metadata and retrieves existing Metadata from the DB
+func (v *View) SyncDBMetadata() (err error) {
+
+	err = v.LoadDBMetadata()
+	if err != nil {
+		return err
+	}
+
+	// A View which hasn't been recorded in the DB needs to be inserted
+	if v.Metadata.MDID < 1 {
+		if len(v.Metadata.Name) == 0 {
+			return fmt.Errorf("Cannot create or find Metadata without a name")
+		}
+		err = v.Metadata.Insert()
+	}
+
+	util.ErrorCheckf(err, "Failed to sync Metadata for View: [%s]", v.Name)
+
+	return err
+}
+
+// GeneratePropertyIDs Generate a PropertyID for the View if it doesn't have one set.
+func (v *View) GeneratePropertyIDs() error {
+	if v.Metadata.PropertyID == "" {
+		viewID := strings.ToLower(v.Metadata.Name)
+
+		if viewID == "" {
+			viewID = strings.ToLower(v.Name)
+		}
+
+		v.ID = viewID
+		v.Metadata.PropertyID = viewID
+
+	} else {
+		v.ID = v.Metadata.PropertyID
+	}
+
+	return nil
+}
+
+// InsertMetadata Insert the View Metadata into the Managment Metadata table
+func (v *View) InsertMetadata() (err error) {
+	err = v.Metadata.OnCreate()
+	util.ErrorCheck(err)
+	return err
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -61,6 +62,20 @@ func (m *ManagementDB) DatabaseGet(project string, name string, env string, resu
 	m.ExpectQuery(query)
 }
 
+// DatabaseGetEnv Mock finding the target database of a project in an environment
+func (m *ManagementDB) DatabaseGetEnv(project string, env string, result DBRow, expectEmpty bool) {
+
+	query := DBQueryMock{
+		Columns: databaseColumns,
+	}
+	if !expectEmpty {
+		query.Rows = []DBRow{result}
+	}
+	query.FormatQuery("SELECT * FROM target_database WHERE project=\"%s\" AND env=\"%s\"", project, env)
+
+	m.ExpectQuery(query)
+}
+
 func (m *ManagementDB) DatabaseInsert(args DBRow, lastInsert int64, rowsAffected int64) {
 
 	query := DBQueryMock{
@@ -129,6 +144,20 @@ func (m *ManagementDB) MetadataInsert(args DBRow, lastInsert int64, rowsAffected
 	m.ExpectExec(query)
 }
 
+// MetadataGetProperty Mock finding the Metadata of a property in a target database
+func (m *ManagementDB) MetadataGetProperty(db int, propertyID string, parentID string, result DBRow, expectEmpty bool) {
+
+	query := DBQueryMock{
+		Columns: metadataColumns,
+	}
+	if !expectEmpty {
+		query.Rows = []DBRow{result}
+	}
+	query.FormatQuery("SELECT * FROM metadata WHERE db = %d AND property_id=\"%s\" AND parent_id=\"%s\"", db, propertyID, parentID)
+
+	m.ExpectQuery(query)
+}
+
 func (m *ManagementDB) MetadataSelectName(name string, result DBRow, expectEmpty bool) {
 	query := DBQueryMock{
 		Columns: metadataColumns,
@@ -167,6 +196,28 @@ func (m *ManagementDB) MetadataLoadAllTableMetadata(tblName, tblPropertyID strin
 	m.ExpectQuery(query)
 }
 
+// MetadataSetTableExists Mock marking a Table and all of its fields as existing
+func (m *ManagementDB) MetadataSetTableExists(name string, dbID int) {
+	query := DBQueryMock{
+		Type:   ExecCmd,
+		Result: sqlmock.NewResult(0, 1),
+	}
+	query.FormatQuery("UPDATE metadata SET `exists` = 1 WHERE name = \"%s\" OR parent_id = \"%s\" AND db = %d", name, name, dbID)
+
+	m.ExpectExec(query)
+}
+
+// MetadataMarkNonExistTable Mock marking a Table and all of its fields as not existing
+func (m *ManagementDB) MetadataMarkNonExistTable(name string, dbID int) {
+	query := DBQueryMock{
+		Type:   ExecCmd,
+		Result: sqlmock.NewResult(0, 1),
+	}
+	query.FormatQuery("UPDATE `metadata` SET `exists` = 0 WHERE name = \"%s\" OR parent_id = \"%s\" AND db = %d", name, name, dbID)
+
+	m.ExpectExec(query)
+}
+
 func (m *ManagementDB) MetadataCreateTable() {
 
 	ct := []string{
@@ -198,10 +249,13 @@ var migrationColumns = []string{
 	"version_description",
 	"status",
 	"vetted_by",
+	"applied_at",
+	"applied_by",
+	"promoted_from",
 	"timestamp",
 }
 
-var migrationValuesTemplate = " values (null,?,?,?,?,?,?,?,?)"
+var migrationValuesTemplate = " values (null,?,?,?,?,?,?,?,?,?,?,?)"
 
 func (m *ManagementDB) MigrationGet(mid int64, result DBRow, expectEmpty bool) {
 	query := DBQueryMock{
@@ -215,14 +269,14 @@ func (m *ManagementDB) MigrationGet(mid int64, result DBRow, expectEmpty bool) {
 	m.ExpectQuery(query)
 }
 
-func (m *ManagementDB) MigrationGetLatest(result DBRow, expectEmpty bool) {
+func (m *ManagementDB) MigrationGetLatest(db int, result DBRow, expectEmpty bool) {
 	query := DBQueryMock{
 		Columns: migrationColumns,
 	}
 	if !expectEmpty {
 		query.Rows = append(query.Rows, result)
 	}
-	query.FormatQuery("select * from migration ORDER BY version_timestamp DESC LIMIT 1")
+	query.FormatQuery("select * from migration WHERE db = %d ORDER BY version_timestamp DESC LIMIT 1", db)
 
 	m.ExpectQuery(query)
 }
@@ -254,6 +308,20 @@ func (m *ManagementDB) MigrationGetVersionExists(version string, result DBRow, e
 	m.ExpectQuery(query)
 }
 
+// MigrationGetPredecessor Mock finding the Migration of a version which is Complete in an environment
+func (m *ManagementDB) MigrationGetPredecessor(project string, version string, env string, result DBRow, expectEmpty bool) {
+
+	query := DBQueryMock{
+		Columns: migrationColumns,
+	}
+	if !expectEmpty {
+		query.Rows = append(query.Rows, result)
+	}
+	query.FormatQuery("JOIN target_database d ON m.db = d.dbid WHERE d.project = \"%s\" AND d.env = \"%s\" AND m.version = \"%s\"", project, env, version)
+
+	m.ExpectQuery(query)
+}
+
 func (m *ManagementDB) MigrationGetStatus(status int, results []DBRow, expectEmpty bool) {
 
 	query := DBQueryMock{
@@ -281,7 +349,7 @@ func (m *ManagementDB) MigrationSetStatus(mid int64, status int) {
 func (m *ManagementDB) MigrationInsert(args DBRow, lastInsert int64, rowsAffected int64) {
 
 	queryStr := fmt.Sprintf(
-		"insert into `migration` (`%s`) values (null,?,?,?,?,?,?,?)",
+		"insert into `migration` (`%s`) values (null,?,?,?,?,?,?,?,?,?,?)",
 		strings.Join(migrationColumns[:len(migrationColumns)-1], "`,`"),
 	)
 	query := DBQueryMock{
@@ -306,6 +374,9 @@ func (m *ManagementDB) MigrationCreateTable() {
 		" `version_description` text,",
 		" `status` int(11) NOT NULL,",
 		" `vetted_by` varchar(255) NOT NULL,",
+		" `applied_at` varchar(32) NOT NULL DEFAULT '',",
+		" `applied_by` varchar(255) NOT NULL DEFAULT '',",
+		" `promoted_from` bigint(20) NOT NULL DEFAULT 0,",
 		" `timestamp` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,",
 		" PRIMARY KEY (`mid`) ",
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8;",
@@ -330,9 +401,18 @@ var migrationStepsColumns = []string{
 	"output",
 	"status",
 	"vetted_by",
+	"safe",
+	"operations",
+	"started",
+	"finished",
+	"duration_ms",
+	"host",
+	"executor",
+	"rows_affected",
+	"table_name",
 }
 
-var migrationStepsValuesTemplate = " values (null,?,?,?,?,?,?,?,?,?)"
+var migrationStepsValuesTemplate = " values (null,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
 
 func (m *ManagementDB) MigrationStepGet(mid int64, result DBRow, expectEmpty bool) {
 	query := DBQueryMock{
@@ -346,6 +426,30 @@ func (m *ManagementDB) MigrationStepGet(mid int64, result DBRow, expectEmpty boo
 	m.ExpectQuery(query)
 }
 
+// MigrationStepsGet Mock loading multiple Steps of a Migration
+func (m *ManagementDB) MigrationStepsGet(mid int64, results []DBRow, expectEmpty bool) {
+	query := DBQueryMock{
+		Columns: migrationStepsColumns,
+	}
+	if !expectEmpty {
+		query.Rows = results
+	}
+	query.FormatQuery("SELECT * FROM `migration_steps` WHERE mid=%d", mid)
+
+	m.ExpectQuery(query)
+}
+
+// MigrationStepsGetTable Mock loading the Steps applied to a table of the target database
+func (m *ManagementDB) MigrationStepsGetTable(db int, tableName string, results []DBRow) {
+	query := DBQueryMock{
+		Columns: migrationStepsColumns,
+		Rows:    results,
+	}
+	query.FormatQuery("JOIN migration m ON m.mid = s.mid WHERE m.db = %d AND s.status IN (%d,%d) AND s.started <> '' AND s.finished <> '' AND s.table_name = '%s'", db, 4, 5, tableName)
+
+	m.ExpectQuery(query)
+}
+
 func (m *ManagementDB) MigrationInsertStep(args DBRow, lastInsert int64, rowsAffected int64) {
 
 	query := DBQueryMock{
@@ -378,11 +482,20 @@ func (m *ManagementDB) MigrationStepCreateTable() {
 		" `op` int(11) DEFAULT NULL,",
 		" `mdid` bigint(20) DEFAULT NULL,",
 		" `name` varchar(255) DEFAULT NULL,",
-		" `forward` varchar(255) DEFAULT NULL,",
-		" `backward` varchar(255) DEFAULT NULL,",
+		" `forward` text,",
+		" `backward` text,",
 		" `output` text,",
 		" `status` int(11) DEFAULT NULL,",
 		" `vetted_by` varchar(255) NOT NULL,",
+		" `safe` tinyint(1) NOT NULL DEFAULT 0,",
+		" `operations` text,",
+		" `started` varchar(32) NOT NULL DEFAULT '',",
+		" `finished` varchar(32) NOT NULL DEFAULT '',",
+		" `duration_ms` bigint(20) NOT NULL DEFAULT 0,",
+		" `host` varchar(255) NOT NULL DEFAULT '',",
+		" `executor` varchar(32) NOT NULL DEFAULT '',",
+		" `rows_affected` bigint(20) NOT NULL DEFAULT 0,",
+		" `table_name` varchar(255) NOT NULL DEFAULT '',",
 		" PRIMARY KEY (`sid`) ",
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8;",
 	}
@@ -392,3 +505,113 @@ func (m *ManagementDB) MigrationStepCreateTable() {
 	m.Mock.ExpectExec(ctStr).WillReturnResult(sqlmock.NewResult(0, 0))
 
 }
+
+// MigrationSchemaColumns The columns of up to date migration tables as read from information_schema
+var MigrationSchemaColumns = []DBRow{
+	{"migration", "mid", "bigint"},
+	{"migration", "db", "int"},
+	{"migration", "project", "varchar"},
+	{"migration", "version", "varchar"},
+	{"migration", "version_timestamp", "datetime"},
+	{"migration", "version_description", "text"},
+	{"migration", "status", "int"},
+	{"migration", "vetted_by", "varchar"},
+	{"migration", "applied_at", "varchar"},
+	{"migration", "applied_by", "varchar"},
+	{"migration", "promoted_from", "bigint"},
+	{"migration", "timestamp", "datetime"},
+	{"migration_steps", "sid", "bigint"},
+	{"migration_steps", "mid", "bigint"},
+	{"migration_steps", "op", "int"},
+	{"migration_steps", "mdid", "bigint"},
+	{"migration_steps", "name", "varchar"},
+	{"migration_steps", "forward", "text"},
+	{"migration_steps", "backward", "text"},
+	{"migration_steps", "output", "text"},
+	{"migration_steps", "status", "int"},
+	{"migration_steps", "vetted_by", "varchar"},
+	{"migration_steps", "safe", "tinyint"},
+	{"migration_steps", "operations", "text"},
+	{"migration_steps", "started", "varchar"},
+	{"migration_steps", "finished", "varchar"},
+	{"migration_steps", "duration_ms", "bigint"},
+	{"migration_steps", "host", "varchar"},
+	{"migration_steps", "executor", "varchar"},
+	{"migration_steps", "rows_affected", "bigint"},
+	{"migration_steps", "table_name", "varchar"},
+}
+
+// MigrationTableColumns Mock reading the columns of the migration tables when upgrading them
+func (m *ManagementDB) MigrationTableColumns(results []DBRow) {
+	query := DBQueryMock{
+		Query:   "SELECT TABLE_NAME AS table_name, COLUMN_NAME AS column_name, DATA_TYPE AS data_type FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = database() AND TABLE_NAME IN ('migration', 'migration_steps')",
+		Columns: []string{"table_name", "column_name", "data_type"},
+		Rows:    results,
+	}
+	m.ExpectQuery(query)
+}
+
+// MigrationUpgradeColumn Mock adding or modifying a column when upgrading the migration tables
+func (m *ManagementDB) MigrationUpgradeColumn(table string, action string, column string) {
+	m.Mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf("ALTER TABLE `%s` %s COLUMN `%s`", table, action, column))).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// Migration Lock Helpers
+
+func (m *ManagementDB) LockCreateTable() {
+	m.Mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `migration_lock`")).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// Migration Approval Helpers
+
+func (m *ManagementDB) ApprovalCreateTable() {
+	m.Mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `migration_approval`")).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+var approvalColumns = []string{
+	"aid",
+	"mid",
+	"approver",
+	"comment",
+	"timestamp",
+}
+
+// ApprovalGet Mock loading the Approvals of a Migration
+func (m *ManagementDB) ApprovalGet(mid int64, results []DBRow) {
+	query := DBQueryMock{
+		Columns: approvalColumns,
+		Rows:    results,
+	}
+	query.FormatQuery("SELECT * FROM `migration_approval` WHERE mid=%d ORDER BY aid", mid)
+
+	m.ExpectQuery(query)
+}
+
+// ApprovalInsert Mock recording the Approval of a Migration
+func (m *ManagementDB) ApprovalInsert(mid int64, approver string, comment string, lastInsert int64) {
+	m.Mock.ExpectExec(regexp.QuoteMeta("insert into `migration_approval`")).
+		WithArgs(mid, approver, comment, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(lastInsert, 1))
+}
+
+// LockAcquire Mock acquiring the Migration Lock of the target database by this process
+func (m *ManagementDB) LockAcquire(dbid int, mid int64) {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	m.Mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `migration_lock`")).
+		WithArgs(fmt.Sprintf("target_database:%d", dbid), host, os.Getpid(), mid).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// LockRelease Mock releasing the Migration Lock of the target database by this process
+func (m *ManagementDB) LockRelease(dbid int) {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	m.Mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `migration_lock`")).
+		WithArgs(fmt.Sprintf("target_database:%d", dbid), host, os.Getpid()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
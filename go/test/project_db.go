@@ -1,6 +1,9 @@
 package test
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 type ProjectDB struct {
 	MockDB
@@ -18,16 +21,52 @@ func CreateProjectDB(context string, t *testing.T) (p ProjectDB, err error) {
 }
 
 func (m *ProjectDB) ShowTables(results []DBRow, expectEmpty bool) {
+	m.ShowFullTables(results, []DBRow{}, expectEmpty)
+}
+
+// ShowFullTables Mock the listing of the tables and views in the Project DB
+func (m *ProjectDB) ShowFullTables(tables []DBRow, views []DBRow, expectEmpty bool) {
+	m.ShowSchema(tables, views, []DBRow{}, []DBRow{}, expectEmpty)
+}
+
+// ShowSchema Mock the listing of the tables, views, routines and events in the Project DB.
+// Each routine row contains the routine name and type.
+func (m *ProjectDB) ShowSchema(tables []DBRow, views []DBRow, routines []DBRow, events []DBRow, expectEmpty bool) {
 
 	query := DBQueryMock{
-		Query:   "show tables",
-		Columns: []string{"table"},
+		Query:   "show full tables",
+		Columns: []string{"table", "table_type"},
 	}
 
 	if !expectEmpty {
-		query.Rows = results
+		for _, tbl := range tables {
+			query.Rows = append(query.Rows, append(tbl, "BASE TABLE"))
+		}
+		for _, view := range views {
+			query.Rows = append(query.Rows, append(view, "VIEW"))
+		}
 	}
 	m.ExpectQuery(query)
+
+	routineQuery := DBQueryMock{
+		Query:   "select TRIGGER_NAME, 'TRIGGER' from information_schema.TRIGGERS",
+		Columns: []string{"name", "type"},
+	}
+
+	if !expectEmpty {
+		routineQuery.Rows = routines
+	}
+	m.ExpectQuery(routineQuery)
+
+	eventQuery := DBQueryMock{
+		Query:   "select EVENT_NAME from information_schema.EVENTS",
+		Columns: []string{"name"},
+	}
+
+	if !expectEmpty {
+		eventQuery.Rows = events
+	}
+	m.ExpectQuery(eventQuery)
 }
 
 func (m *ProjectDB) ShowCreateTable(name string, createStatement string) {
@@ -47,3 +86,81 @@ func (m *ProjectDB) ShowCreateTable(name string, createStatement string) {
 
 	m.ExpectQuery(query)
 }
+
+func (m *ProjectDB) ShowCreateView(name string, createStatement string) {
+	query := DBQueryMock{
+		Columns: []string{
+			"view",
+			"create_view",
+			"character_set_client",
+			"collation_connection",
+		},
+		Rows: []DBRow{
+			{
+				name,
+				createStatement,
+				"utf8mb4",
+				"utf8mb4_general_ci",
+			},
+		},
+	}
+	query.FormatQuery("show create view %s", name)
+
+	m.ExpectQuery(query)
+}
+
+// ShowCreateRoutine Mock the show create statement for a Trigger, Procedure or Function
+func (m *ProjectDB) ShowCreateRoutine(name string, routineType string, createStatement string) {
+	query := DBQueryMock{
+		Columns: []string{
+			"name",
+			"sql_mode",
+			"create_statement",
+			"character_set_client",
+			"collation_connection",
+			"database_collation",
+		},
+		Rows: []DBRow{
+			{
+				name,
+				"",
+				createStatement,
+				"utf8mb4",
+				"utf8mb4_general_ci",
+				"utf8mb4_general_ci",
+			},
+		},
+	}
+	query.FormatQuery("show create %s %s", strings.ToLower(routineType), name)
+
+	m.ExpectQuery(query)
+}
+
+// ShowCreateEvent Mock the show create statement for an Event
+func (m *ProjectDB) ShowCreateEvent(name string, createStatement string) {
+	query := DBQueryMock{
+		Columns: []string{
+			"event",
+			"sql_mode",
+			"time_zone",
+			"create_event",
+			"character_set_client",
+			"collation_connection",
+			"database_collation",
+		},
+		Rows: []DBRow{
+			{
+				name,
+				"",
+				"SYSTEM",
+				createStatement,
+				"utf8mb4",
+				"utf8mb4_general_ci",
+				"utf8mb4_general_ci",
+			},
+		},
+	}
+	query.FormatQuery("show create event %s", name)
+
+	m.ExpectQuery(query)
+}
@@ -1,6 +1,7 @@
 package testdata
 
 import (
+	"github.com/freneticmonkey/migrate/go/config"
 	"github.com/freneticmonkey/migrate/go/exec"
 	"github.com/freneticmonkey/migrate/go/management"
 	"github.com/freneticmonkey/migrate/go/metadata"
@@ -21,7 +22,7 @@ func Teardown() {
 	exec.SetProjectDB(nil)
 	management.SetManagementDB(nil)
 	mysql.SetProjectDB(nil)
-	exec.Setup(nil, 0, "")
+	exec.Setup(nil, 0, config.Project{})
 	migration.Setup(nil, 1)
 	metadata.Setup(nil, 1)
 
@@ -117,6 +117,38 @@ func DeleteFile(filename string) error {
 	return fs.Remove(filename)
 }
 
+// TempFile Creates a temporary file which is only readable by the current user,
+// containing data, and returns its path.  The caller is responsible for deleting it.
+// Unit tests use a predictable name so that commands referencing it can be mocked.
+func TempFile(prefix string, data []byte) (path string, err error) {
+	var file afero.File
+
+	if isTesting {
+		file, err = fs.OpenFile(filepath.Join(os.TempDir(), prefix), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	} else {
+		file, err = afero.TempFile(fs, "", prefix)
+	}
+	if err != nil {
+		return path, err
+	}
+	path = file.Name()
+
+	if err = fs.Chmod(path, 0600); err == nil {
+		_, err = file.Write(data)
+	}
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		fs.Remove(path)
+		return "", err
+	}
+
+	return path, err
+}
+
 func Mkdir(path string, perm os.FileMode) error {
 	return fs.Mkdir(path, perm)
 }
@@ -1,6 +1,10 @@
 package util
 
-import "strings"
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
 
 func StringInArray(a string, list []string) bool {
 	for _, b := range list {
@@ -27,3 +31,43 @@ func (p *Params) String() string {
 	}
 	return strings.Join(p.Values, p.Sep)
 }
+
+// QuoteSQLString Wraps the value in single quotes, escaping any embedded quotes or backslashes
+func QuoteSQLString(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, "'", "''", -1)
+	return "'" + value + "'"
+}
+
+// UnquoteSQLString Extracts the single quoted value from the start of the s parameter,
+// unescaping any embedded quotes or backslashes.  The remainder of s following the
+// closing quote is also returned.
+func UnquoteSQLString(s string) (value string, remainder string, err error) {
+	if !strings.HasPrefix(s, "'") {
+		return value, s, fmt.Errorf("Quoted value must begin with a single quote: [%s]", s)
+	}
+
+	var buf bytes.Buffer
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				buf.WriteByte(s[i])
+			}
+		case '\'':
+			// An escaped quote is doubled
+			if i+1 < len(s) && s[i+1] == '\'' {
+				i++
+				buf.WriteByte('\'')
+			} else {
+				return buf.String(), s[i+1:], err
+			}
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+
+	return value, s, fmt.Errorf("Quoted value is missing a closing quote: [%s]", s)
+}
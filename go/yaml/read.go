@@ -12,7 +12,10 @@ import (
 )
 
 // ReadTables Read all of the files at path that have the extension 'yml' and parse them
-// into table.Table structs
+// into table.Table structs, or table.View structs for files with the View suffix.
+// Triggers, Procedures and Functions are read from files with a Routine suffix and
+// from files with the extension 'sql' into table.Routine structs, and Events are read
+// from files with the Event suffix into table.Event structs
 func ReadTables(conf config.Config) (err error) {
 	path := strings.ToLower(conf.Project.Name)
 
@@ -67,6 +70,33 @@ func readPath(path string, recursive bool, conf config.Config) (err error) {
 	if err == nil {
 		for _, filename := range schemaList {
 
+			// View files are read separately
+			if strings.HasSuffix(strings.ToLower(filename), table.ViewFileSuffix) {
+				err = readView(filename)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			// Event files are read separately
+			if strings.HasSuffix(strings.ToLower(filename), table.EventFileSuffix) {
+				err = readEvent(filename, conf)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			// Routine files are read separately
+			if routineType := routineFileType(filename); len(routineType) > 0 {
+				err = readRoutine(filename, routineType)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
 			var tbl table.Table
 			err = ReadFile(filename, &tbl)
 			util.LogInfof("Reading YAML Table: %s", filename)
@@ -76,6 +106,9 @@ func readPath(path string, recursive bool, conf config.Config) (err error) {
 			// Process the table metadata
 			processMetadata(&tbl)
 
+			// Apply the MySQL defaults for any omitted properties
+			processDefaults(&tbl)
+
 			// Calculate the table's namespace
 			tbl.SetNamespace(conf)
 
@@ -98,6 +131,144 @@ func readPath(path string, recursive bool, conf config.Config) (err error) {
 			}
 
 		}
+
+		// Routines can also be defined using their SQL CREATE statement
+		var sqlList []string
+		err = util.ReadDirRelative(path, "sql", recursive, &sqlList)
+
+		if err == nil {
+			for _, filename := range sqlList {
+				err = readRoutineSQL(filename)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+func readEvent(filename string, conf config.Config) (err error) {
+	var event table.Event
+	err = ReadFile(filename, &event)
+	util.LogInfof("Reading YAML Event: %s", filename)
+	if err != nil {
+		return err
+	}
+
+	// Process the event metadata
+	processEventMetadata(&event)
+
+	// Apply the MySQL defaults for any omitted properties
+	processEventDefaults(&event)
+
+	// Calculate the event's namespace
+	event.SetNamespace(conf)
+
+	event.Filename = filename
+
+	// If the event has an Id, then it can be used.
+	// Otherwise ignore it.
+	if len(event.Metadata.PropertyID) > 0 {
+		Events = append(Events, event)
+	} else {
+		color.Set(color.FgYellow, color.Bold)
+		util.LogWarn(fmt.Sprintf("Event in file: [%s] is missing an event id and is being ignored.", filename))
+		color.Unset()
+	}
+
+	return err
+}
+
+// routineFileType Returns the Routine Type identified by the suffix of filename,
+// or an empty string if the file doesn't define a Routine
+func routineFileType(filename string) string {
+	for _, routineType := range table.RoutineTypes {
+		if strings.HasSuffix(strings.ToLower(filename), table.RoutineFileSuffix(routineType)) {
+			return routineType
+		}
+	}
+	return ""
+}
+
+func readRoutine(filename string, routineType string) (err error) {
+	var routine table.Routine
+	err = ReadFile(filename, &routine)
+	util.LogInfof("Reading YAML %s: %s", strings.Title(strings.ToLower(routineType)), filename)
+	if err != nil {
+		return err
+	}
+
+	if len(routine.Type) == 0 {
+		routine.Type = routineType
+	}
+
+	return addRoutine(filename, routine)
+}
+
+func readRoutineSQL(filename string) (err error) {
+	var data []byte
+	var routine table.Routine
+
+	data, err = util.ReadFile(filename)
+	util.LogInfof("Reading SQL Routine: %s", filename)
+	if util.ErrorCheckf(err, "Error Reading File: %s", filename) {
+		return err
+	}
+
+	routine.Definition = string(data)
+
+	return addRoutine(filename, routine)
+}
+
+// addRoutine Validates the definition of a Routine read from filename and
+// adds it to the YAML Routines
+func addRoutine(filename string, routine table.Routine) (err error) {
+	err = routine.ParseDefinition()
+	if util.ErrorCheckf(err, "Invalid Routine in file: [%s]", filename) {
+		return err
+	}
+
+	// SQL files don't have an id, so the name is used instead
+	if len(routine.ID) == 0 {
+		routine.ID = strings.ToLower(routine.Name)
+	}
+
+	// Process the routine metadata
+	processRoutineMetadata(&routine)
+
+	routine.Filename = filename
+
+	Routines = append(Routines, routine)
+
+	return err
+}
+
+func readView(filename string) (err error) {
+	var view table.View
+	err = ReadFile(filename, &view)
+	util.LogInfof("Reading YAML View: %s", filename)
+	if err != nil {
+		return err
+	}
+
+	// Process the view metadata
+	processViewMetadata(&view)
+
+	// Apply the MySQL defaults for any omitted properties
+	processViewDefaults(&view)
+
+	view.Filename = filename
+
+	// If the view has an Id, then it can be used.
+	// Otherwise ignore it.
+	if len(view.Metadata.PropertyID) > 0 {
+		Views = append(Views, view)
+	} else {
+		color.Set(color.FgYellow, color.Bold)
+		util.LogWarn(fmt.Sprintf("View in file: [%s] is missing a view id and is being ignored.", filename))
+		color.Unset()
 	}
 
 	return err
@@ -8,6 +8,15 @@ import (
 // Schema The parsed from the YAML tables
 var Schema table.Tables
 
+// Views The views parsed from the YAML files
+var Views table.Views
+
+// Routines The triggers, procedures and functions parsed from the YAML and SQL files
+var Routines table.Routines
+
+// Events The events parsed from the YAML files
+var Events table.Events
+
 var useNamespaces bool
 
 func Setup(conf config.Config) {
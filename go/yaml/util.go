@@ -30,10 +30,10 @@ func ReadData(file string, data []byte, out interface{}) (err error) {
 	return err
 }
 
-func WriteFile(file string, tbl table.Table) (err error) {
+func WriteFile(file string, in interface{}) (err error) {
 	var exists bool
 
-	filedata, err := yaml.Marshal(tbl)
+	filedata, err := yaml.Marshal(in)
 	if err != nil {
 		return err
 	}
@@ -88,4 +88,85 @@ func processMetadata(t *table.Table) {
 			Type:       "Index",
 		}
 	}
+
+	for i, fk := range t.ForeignKeys {
+		t.ForeignKeys[i].Metadata = metadata.Metadata{
+			PropertyID: fk.ID,
+			ParentID:   t.ID,
+			Name:       fk.Name,
+			Type:       "ForeignKey",
+		}
+	}
+
+	for i, chk := range t.CheckConstraints {
+		t.CheckConstraints[i].Metadata = metadata.Metadata{
+			PropertyID: chk.ID,
+			ParentID:   t.ID,
+			Name:       chk.Name,
+			Type:       "CheckConstraint",
+		}
+	}
+}
+
+// Postprocess the loaded YAML table to explicitly set any values which MySQL
+// will report with a default value, preventing false differences
+func processDefaults(t *table.Table) {
+	for i, col := range t.Columns {
+		if col.IsGenerated() && len(col.Storage) == 0 {
+			t.Columns[i].Storage = table.ColumnVirtual
+		}
+	}
+	for i, fk := range t.ForeignKeys {
+		t.ForeignKeys[i].OnDelete = table.NormalizeReferenceAction(fk.OnDelete)
+		t.ForeignKeys[i].OnUpdate = table.NormalizeReferenceAction(fk.OnUpdate)
+	}
+}
+
+// Postprocess the loaded YAML view for it's Metadata
+func processViewMetadata(v *table.View) {
+	v.Metadata = metadata.Metadata{
+		PropertyID: v.ID,
+		Name:       v.Name,
+		Type:       "View",
+	}
+}
+
+// Postprocess the loaded YAML view to explicitly set any values which MySQL
+// will report with a default value, preventing false differences
+func processViewDefaults(v *table.View) {
+	if len(v.Algorithm) == 0 {
+		v.Algorithm = table.ViewAlgorithmUndefined
+	}
+	if len(v.Security) == 0 {
+		v.Security = table.ViewSecurityDefiner
+	}
+}
+
+// Postprocess the loaded YAML or SQL routine for it's Metadata
+func processRoutineMetadata(r *table.Routine) {
+	r.Metadata = metadata.Metadata{
+		PropertyID: r.ID,
+		Name:       r.Name,
+		Type:       r.MetadataType(),
+	}
+}
+
+// Postprocess the loaded YAML event for it's Metadata
+func processEventMetadata(e *table.Event) {
+	e.Metadata = metadata.Metadata{
+		PropertyID: e.ID,
+		Name:       e.Name,
+		Type:       "Event",
+	}
+}
+
+// Postprocess the loaded YAML event to explicitly set any values which MySQL
+// will report with a default value, preventing false differences
+func processEventDefaults(e *table.Event) {
+	if len(e.Status) == 0 {
+		e.Status = table.EventStatusEnable
+	}
+	if len(e.OnCompletion) == 0 {
+		e.OnCompletion = table.EventNotPreserve
+	}
 }
@@ -2,6 +2,7 @@ package yaml
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/freneticmonkey/migrate/go/table"
 	"github.com/freneticmonkey/migrate/go/util"
@@ -26,3 +27,32 @@ func WriteTable(path string, tbl table.Table) (err error) {
 
 	return err
 }
+
+// WriteView Serialise the View as YAML and write it to path
+func WriteView(path string, view table.View) (err error) {
+	filepath := filepath.Join(path, view.Name+table.ViewFileSuffix)
+	util.LogInfof("Writing to File PATH: %s", filepath)
+	err = WriteFile(filepath, view)
+
+	return err
+}
+
+// WriteRoutine Serialise the Trigger, Procedure or Function as YAML and write it to path
+func WriteRoutine(path string, routine table.Routine) (err error) {
+	filepath := filepath.Join(path, routine.Name+table.RoutineFileSuffix(routine.Type))
+	util.LogInfof("Writing to File PATH: %s", filepath)
+	err = WriteFile(filepath, routine)
+
+	return err
+}
+
+// WriteEvent Serialise the Event as YAML and write it to the path of its namespace
+func WriteEvent(path string, event table.Event) (err error) {
+	event.Namespace.SetTableFilename("<table>")
+	filename := event.Namespace.GenerateSchemaFilename(strings.TrimPrefix(table.EventFileSuffix, "."))
+	filepath := filepath.Join(path, filename)
+	util.LogInfof("Writing to File PATH: %s", filepath)
+	err = WriteFile(filepath, event)
+
+	return err
+}
@@ -68,6 +68,32 @@ var yamlTests = []ParseTest{
 		ExpectFail:  false,
 		Description: "YAML Parse: Basic Column",
 	},
+	// Column Comment
+	{
+		Str: `
+        comment:  "Stores the user's details"
+        columns:
+            - name:     id
+              type:     int
+              size:     [11]
+              id:       col1
+              comment:  "Unique id"
+        `,
+		Expected: table.Table{
+			Comment: "Stores the user's details",
+			Columns: []table.Column{
+				table.Column{
+					ID:      "col1",
+					Name:    "id",
+					Type:    "int",
+					Size:    []int{11},
+					Comment: "Unique id",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "YAML Parse: Table and Column Comments",
+	},
 	// Single Column
 	{
 		Str: `
@@ -242,6 +268,60 @@ var yamlTests = []ParseTest{
 		ExpectFail:  false,
 		Description: "YAML Parse: SecondaryIndexes multi column with partial index",
 	},
+	{
+		Str: `
+        secondaryindexes:
+            - id:      sc1
+              name:    idx_body
+              kind:    FULLTEXT
+              parser:  ngram
+              columns:
+                - name: body
+        `,
+		Expected: table.Table{
+			SecondaryIndexes: []table.Index{
+				table.Index{
+					ID:   "sc1",
+					Name: "idx_body",
+					Columns: []table.IndexColumn{
+						{
+							Name: "body",
+						},
+					},
+					Kind:   table.IndexFullText,
+					Parser: "ngram",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "YAML Parse: SecondaryIndexes FULLTEXT with parser",
+	},
+	// Foreign Key Parsing
+	{
+		Str: `
+        foreignkeys:
+            - id:               fk1
+              name:             fk_user
+              columns:          [user_id]
+              referencetable:   user
+              referencecolumns: [id]
+              ondelete:         CASCADE
+        `,
+		Expected: table.Table{
+			ForeignKeys: []table.ForeignKey{
+				table.ForeignKey{
+					ID:               "fk1",
+					Name:             "fk_user",
+					Columns:          []string{"user_id"},
+					ReferenceTable:   "user",
+					ReferenceColumns: []string{"id"},
+					OnDelete:         "CASCADE",
+				},
+			},
+		},
+		ExpectFail:  false,
+		Description: "YAML Parse: ForeignKeys single column",
+	},
 }
 
 func validateResult(test ParseTest, result interface{}, err error, t *testing.T) {
@@ -274,3 +354,43 @@ func TestYAMLParse(t *testing.T) {
 	}
 
 }
+
+func TestProcessDefaultsForeignKeys(t *testing.T) {
+	testName := "TestProcessDefaultsForeignKeys"
+
+	var result table.Table
+
+	// MySQL omits the default referential actions, so they must not cause a difference
+	err := ReadData("testfile", []byte(`
+        foreignkeys:
+            - id:               fk1
+              name:             fk_user
+              columns:          [user_id]
+              referencetable:   user
+              referencecolumns: [id]
+              ondelete:         RESTRICT
+              onupdate:         no action
+            - id:               fk2
+              name:             fk_order
+              columns:          [order_id]
+              referencetable:   order
+              referencecolumns: [id]
+              ondelete:         cascade
+        `), &result)
+	if err != nil {
+		t.Errorf("%s FAILED with error: %v", testName, err)
+		return
+	}
+
+	processDefaults(&result)
+
+	expected := [][]string{
+		{"", ""},
+		{"CASCADE", ""},
+	}
+	for i, fk := range result.ForeignKeys {
+		if fk.OnDelete != expected[i][0] || fk.OnUpdate != expected[i][1] {
+			t.Errorf("%s FAILED. Foreign Key: [%s] Expected ON DELETE: [%s] ON UPDATE: [%s] Got: [%s] [%s]", testName, fk.Name, expected[i][0], expected[i][1], fk.OnDelete, fk.OnUpdate)
+		}
+	}
+}